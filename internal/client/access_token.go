@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// AccessTokenCreate represents the request to mint a short-lived access token
+type AccessTokenCreate struct {
+	Scopes     []string `json:"scopes"`
+	TTLSeconds int64    `json:"ttl_seconds"`
+}
+
+// AccessTokenRead represents a short-lived access token response
+type AccessTokenRead struct {
+	ID        string   `json:"id"`
+	Token     string   `json:"token"`
+	Scopes    []string `json:"scopes"`
+	ExpiresAt string   `json:"expires_at"`
+}
+
+// CreateAccessToken mints a short-lived access token for the calling principal
+func (c *Client) CreateAccessToken(ctx context.Context, token *AccessTokenCreate) (*AccessTokenRead, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, "/access-tokens/", token)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result AccessTokenRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// RevokeAccessToken revokes a short-lived access token by ID
+func (c *Client) RevokeAccessToken(ctx context.Context, tokenID string) error {
+	path := fmt.Sprintf("/access-tokens/%s", tokenID)
+	resp, err := c.doRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	if err := checkResponse(resp); err != nil {
+		return err
+	}
+
+	return nil
+}
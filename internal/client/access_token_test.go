@@ -0,0 +1,74 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCreateAccessToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST request, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/access-tokens/" {
+			t.Errorf("expected path /access-tokens/, got %s", r.URL.Path)
+		}
+
+		var token AccessTokenCreate
+		if err := json.NewDecoder(r.Body).Decode(&token); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+
+		if token.TTLSeconds != 900 {
+			t.Errorf("expected TTLSeconds 900, got %d", token.TTLSeconds)
+		}
+
+		response := AccessTokenRead{
+			ID:        "token-123",
+			Token:     "secret-value",
+			Scopes:    token.Scopes,
+			ExpiresAt: "2025-01-01T00:15:00Z",
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	result, err := client.CreateAccessToken(context.Background(), &AccessTokenCreate{
+		Scopes:     []string{"pipeline:read"},
+		TTLSeconds: 900,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Token != "secret-value" {
+		t.Errorf("expected Token secret-value, got %s", result.Token)
+	}
+}
+
+func TestRevokeAccessToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected DELETE request, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/access-tokens/token-123" {
+			t.Errorf("expected path /access-tokens/token-123, got %s", r.URL.Path)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	if err := client.RevokeAccessToken(context.Background(), "token-123"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
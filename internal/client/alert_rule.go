@@ -0,0 +1,150 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// AlertRuleCreate represents the request to create an alert rule. The rule
+// fires when the metric of the scoped object compares to the value with the
+// operator for at least ForSeconds seconds.
+type AlertRuleCreate struct {
+	Name                   string   `json:"name"`
+	Enabled                bool     `json:"enabled"`
+	ScopeType              string   `json:"scope_type"`
+	ScopeID                string   `json:"scope_id"`
+	Metric                 string   `json:"metric"`
+	Operator               string   `json:"operator"`
+	Value                  string   `json:"value"`
+	ForSeconds             int64    `json:"for_seconds"`
+	NotificationChannelIDs []string `json:"notification_channel_ids"`
+}
+
+// AlertRuleUpdate represents the request to update an alert rule
+type AlertRuleUpdate struct {
+	Name                   *string   `json:"name,omitempty"`
+	Enabled                *bool     `json:"enabled,omitempty"`
+	Metric                 *string   `json:"metric,omitempty"`
+	Operator               *string   `json:"operator,omitempty"`
+	Value                  *string   `json:"value,omitempty"`
+	ForSeconds             *int64    `json:"for_seconds,omitempty"`
+	NotificationChannelIDs *[]string `json:"notification_channel_ids,omitempty"`
+}
+
+// AlertRuleRead represents an alert rule response
+type AlertRuleRead struct {
+	ID                     string   `json:"id"`
+	Name                   string   `json:"name"`
+	Enabled                bool     `json:"enabled"`
+	ScopeType              string   `json:"scope_type"`
+	ScopeID                string   `json:"scope_id"`
+	Metric                 string   `json:"metric"`
+	Operator               string   `json:"operator"`
+	Value                  string   `json:"value"`
+	ForSeconds             int64    `json:"for_seconds"`
+	NotificationChannelIDs []string `json:"notification_channel_ids"`
+}
+
+// CreateAlertRule creates a new alert rule
+func (c *Client) CreateAlertRule(ctx context.Context, alertRule *AlertRuleCreate) (*AlertRuleRead, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, "/alert-rules/", alertRule)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result AlertRuleRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetAlertRule retrieves a alert rule by ID
+func (c *Client) GetAlertRule(ctx context.Context, alertRuleID string) (*AlertRuleRead, error) {
+	path := fmt.Sprintf("/alert-rules/%s", alertRuleID)
+	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result AlertRuleRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// UpdateAlertRule updates an existing alert rule
+func (c *Client) UpdateAlertRule(ctx context.Context, alertRuleID string, alertRule *AlertRuleUpdate) (*AlertRuleRead, error) {
+	path := fmt.Sprintf("/alert-rules/%s", alertRuleID)
+	resp, err := c.doRequest(ctx, http.MethodPatch, path, alertRule)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result AlertRuleRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// DeleteAlertRule deletes a alert rule by ID
+func (c *Client) DeleteAlertRule(ctx context.Context, alertRuleID string) error {
+	path := fmt.Sprintf("/alert-rules/%s", alertRuleID)
+	resp, err := c.doRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	if err := checkResponse(resp); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCreateAlertRule(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST request, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/alert-rules/" {
+			t.Errorf("expected path /alert-rules/, got %s", r.URL.Path)
+		}
+
+		var rule AlertRuleCreate
+		if err := json.NewDecoder(r.Body).Decode(&rule); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+
+		response := AlertRuleRead{
+			ID:                     "rule-123",
+			Name:                   rule.Name,
+			Enabled:                rule.Enabled,
+			ScopeType:              rule.ScopeType,
+			ScopeID:                rule.ScopeID,
+			Metric:                 rule.Metric,
+			Operator:               rule.Operator,
+			Value:                  rule.Value,
+			ForSeconds:             rule.ForSeconds,
+			NotificationChannelIDs: rule.NotificationChannelIDs,
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	result, err := client.CreateAlertRule(context.Background(), &AlertRuleCreate{
+		Name:                   "orders-lag",
+		Enabled:                true,
+		ScopeType:              "pipeline",
+		ScopeID:                "pipeline-123",
+		Metric:                 "consumer_lag",
+		Operator:               "gt",
+		Value:                  "10000",
+		ForSeconds:             300,
+		NotificationChannelIDs: []string{"channel-123"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.ID != "rule-123" {
+		t.Errorf("expected ID rule-123, got %s", result.ID)
+	}
+
+	if result.ForSeconds != 300 {
+		t.Errorf("expected ForSeconds 300, got %d", result.ForSeconds)
+	}
+}
+
+func TestUpdateAlertRule_Disable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("expected PATCH request, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/alert-rules/rule-123" {
+			t.Errorf("expected path /alert-rules/rule-123, got %s", r.URL.Path)
+		}
+
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+
+		// false must be sent rather than omitted
+		if enabled, ok := body["enabled"]; !ok || enabled != false {
+			t.Errorf("expected enabled false in request, got %v", body)
+		}
+
+		response := AlertRuleRead{ID: "rule-123", Enabled: false}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	enabled := false
+	result, err := client.UpdateAlertRule(context.Background(), "rule-123", &AlertRuleUpdate{Enabled: &enabled})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Enabled {
+		t.Error("expected Enabled false")
+	}
+}
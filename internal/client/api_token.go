@@ -0,0 +1,105 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// APITokenCreate represents the request to create an API token.
+// Tokens without a service account belong to the calling principal.
+type APITokenCreate struct {
+	Name             string   `json:"name"`
+	ServiceAccountID *string  `json:"service_account_id,omitempty"`
+	Scopes           []string `json:"scopes"`
+	ExpiresAt        *string  `json:"expires_at,omitempty"`
+}
+
+// APITokenRead represents an API token response. The token value is
+// only returned by CreateAPIToken.
+type APITokenRead struct {
+	ID               string   `json:"id"`
+	Name             string   `json:"name"`
+	ServiceAccountID *string  `json:"service_account_id"`
+	Scopes           []string `json:"scopes"`
+	ExpiresAt        *string  `json:"expires_at"`
+	CreatedAt        string   `json:"created_at"`
+	Token            string   `json:"token,omitempty"`
+}
+
+// CreateAPIToken creates a new API token
+func (c *Client) CreateAPIToken(ctx context.Context, token *APITokenCreate) (*APITokenRead, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, "/api-tokens/", token)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result APITokenRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetAPIToken retrieves an API token by ID
+func (c *Client) GetAPIToken(ctx context.Context, tokenID string) (*APITokenRead, error) {
+	path := fmt.Sprintf("/api-tokens/%s", tokenID)
+	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result APITokenRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// RevokeAPIToken revokes an API token by ID
+func (c *Client) RevokeAPIToken(ctx context.Context, tokenID string) error {
+	path := fmt.Sprintf("/api-tokens/%s", tokenID)
+	resp, err := c.doRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	if err := checkResponse(resp); err != nil {
+		return err
+	}
+
+	return nil
+}
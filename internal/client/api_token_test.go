@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCreateAPIToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST request, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/api-tokens/" {
+			t.Errorf("expected path /api-tokens/, got %s", r.URL.Path)
+		}
+
+		var token APITokenCreate
+		if err := json.NewDecoder(r.Body).Decode(&token); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+
+		response := APITokenRead{
+			ID:               "token-123",
+			Name:             token.Name,
+			ServiceAccountID: token.ServiceAccountID,
+			Scopes:           token.Scopes,
+			CreatedAt:        "2025-01-01T00:00:00Z",
+			Token:            "secret-value",
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	serviceAccountID := "sa-123"
+	result, err := client.CreateAPIToken(context.Background(), &APITokenCreate{
+		Name:             "ci",
+		ServiceAccountID: &serviceAccountID,
+		Scopes:           []string{"pipeline:read"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Token != "secret-value" {
+		t.Errorf("expected Token secret-value, got %s", result.Token)
+	}
+
+	if result.ServiceAccountID == nil || *result.ServiceAccountID != "sa-123" {
+		t.Errorf("expected ServiceAccountID sa-123, got %v", result.ServiceAccountID)
+	}
+}
+
+func TestRevokeAPIToken_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected DELETE request, got %s", r.Method)
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	err := client.RevokeAPIToken(context.Background(), "nonexistent")
+	// Should not return error for not found
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
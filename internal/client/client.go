@@ -4,17 +4,28 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
 	"time"
 )
 
+// defaultPageSize is the number of items requested per page by list calls
+const defaultPageSize = 100
+
 // Client manages communication with the Popsink API
 type Client struct {
 	BaseURL    string
 	Token      string
 	HTTPClient *http.Client
+
+	// organizationID caches the organization of the token once it has been retrieved
+	organizationMu sync.Mutex
+	organizationID string
 }
 
 // NewClient creates a new Popsink API client
@@ -57,6 +68,23 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body any) (
 	return resp, nil
 }
 
+// APIError is returned for API responses with an error status
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+// Error returns the status and body of the failed API response
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API request failed with status %d: %s", e.StatusCode, e.Body)
+}
+
+// IsNotFound reports whether an error is an API response for a missing object
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
 // checkResponse checks the API response for errors
 func checkResponse(resp *http.Response) error {
 	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
@@ -66,5 +94,64 @@ func checkResponse(resp *http.Response) error {
 	bodyBytes, _ := io.ReadAll(resp.Body)
 	_ = resp.Body.Close()
 
-	return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+	return &APIError{StatusCode: resp.StatusCode, Body: string(bodyBytes)}
+}
+
+// Page represents a single page of a paginated list response
+type Page[T any] struct {
+	Items []T `json:"items"`
+	Total int `json:"total"`
+	Page  int `json:"page"`
+	Size  int `json:"size"`
+	Pages int `json:"pages"`
+}
+
+// listAll retrieves every item of a paginated list endpoint
+func listAll[T any](ctx context.Context, c *Client, path string, query url.Values) ([]T, error) {
+	if query == nil {
+		query = url.Values{}
+	}
+	query.Set("size", strconv.Itoa(defaultPageSize))
+
+	var items []T
+	for page := 1; ; page++ {
+		query.Set("page", strconv.Itoa(page))
+
+		resp, err := c.doRequest(ctx, http.MethodGet, path+"?"+query.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := decodePage[T](resp)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, result.Items...)
+
+		if page >= result.Pages || len(result.Items) == 0 {
+			return items, nil
+		}
+	}
+}
+
+// decodePage checks and decodes a single page of a paginated list response
+func decodePage[T any](resp *http.Response) (*Page[T], error) {
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result Page[T]
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
 }
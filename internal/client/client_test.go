@@ -82,3 +82,31 @@ func TestCheckResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestListAll_Pagination(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("size") != "100" {
+			t.Errorf("expected size 100, got %s", r.URL.Query().Get("size"))
+		}
+
+		switch r.URL.Query().Get("page") {
+		case "1":
+			_, _ = w.Write([]byte(`{"items": ["a", "b"], "total": 3, "page": 1, "size": 100, "pages": 2}`))
+		case "2":
+			_, _ = w.Write([]byte(`{"items": ["c"], "total": 3, "page": 2, "size": 100, "pages": 2}`))
+		default:
+			t.Errorf("unexpected page %s", r.URL.Query().Get("page"))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	items, err := listAll[string](context.Background(), client, "/items/", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(items) != 3 || items[0] != "a" || items[2] != "c" {
+		t.Errorf("expected items [a b c], got %v", items)
+	}
+}
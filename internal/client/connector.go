@@ -0,0 +1,150 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// ConnectorKind represents the side of a pipeline a connector is used on
+type ConnectorKind string
+
+const (
+	ConnectorKindSource ConnectorKind = "source"
+	ConnectorKindTarget ConnectorKind = "target"
+)
+
+// basePath returns the API path of the connectors of this kind
+func (k ConnectorKind) basePath() string {
+	return fmt.Sprintf("/%s-connectors/", k)
+}
+
+// ConnectorCreate represents the request to create a connector
+type ConnectorCreate struct {
+	Name   string         `json:"name"`
+	Type   string         `json:"type"`
+	TeamID *string        `json:"team_id,omitempty"`
+	EnvID  *string        `json:"env_id,omitempty"`
+	Config map[string]any `json:"config"`
+}
+
+// ConnectorUpdate represents the request to update a connector
+type ConnectorUpdate struct {
+	Name   *string         `json:"name,omitempty"`
+	Config *map[string]any `json:"config,omitempty"`
+}
+
+// ConnectorRead represents a connector response. Revision is incremented
+// every time the connector configuration changes.
+type ConnectorRead struct {
+	ID       string         `json:"id"`
+	Name     string         `json:"name"`
+	Type     string         `json:"type"`
+	TeamID   *string        `json:"team_id"`
+	EnvID    *string        `json:"env_id"`
+	Config   map[string]any `json:"config"`
+	Revision int64          `json:"revision"`
+}
+
+// CreateConnector creates a new connector
+func (c *Client) CreateConnector(ctx context.Context, kind ConnectorKind, connector *ConnectorCreate) (*ConnectorRead, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, kind.basePath(), connector)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result ConnectorRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetConnector retrieves a connector by ID
+func (c *Client) GetConnector(ctx context.Context, kind ConnectorKind, connectorID string) (*ConnectorRead, error) {
+	path := kind.basePath() + connectorID
+	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result ConnectorRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// UpdateConnector updates an existing connector
+func (c *Client) UpdateConnector(ctx context.Context, kind ConnectorKind, connectorID string, connector *ConnectorUpdate) (*ConnectorRead, error) {
+	path := kind.basePath() + connectorID
+	resp, err := c.doRequest(ctx, http.MethodPatch, path, connector)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result ConnectorRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// DeleteConnector deletes a connector by ID
+func (c *Client) DeleteConnector(ctx context.Context, kind ConnectorKind, connectorID string) error {
+	path := kind.basePath() + connectorID
+	resp, err := c.doRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	if err := checkResponse(resp); err != nil {
+		return err
+	}
+
+	return nil
+}
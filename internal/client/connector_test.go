@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCreateConnector(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST request, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/source-connectors/" {
+			t.Errorf("expected path /source-connectors/, got %s", r.URL.Path)
+		}
+
+		var connector ConnectorCreate
+		if err := json.NewDecoder(r.Body).Decode(&connector); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+
+		response := ConnectorRead{
+			ID:       "connector-123",
+			Name:     connector.Name,
+			Type:     connector.Type,
+			TeamID:   connector.TeamID,
+			Config:   connector.Config,
+			Revision: 1,
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	teamID := "team-123"
+	result, err := client.CreateConnector(context.Background(), ConnectorKindSource, &ConnectorCreate{
+		Name:   "orders-kafka",
+		Type:   "KAFKA_SOURCE",
+		TeamID: &teamID,
+		Config: map[string]any{"bootstrap_servers": "kafka:9092"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Revision != 1 {
+		t.Errorf("expected Revision 1, got %d", result.Revision)
+	}
+
+	if result.Config["bootstrap_servers"] != "kafka:9092" {
+		t.Errorf("expected bootstrap_servers kafka:9092, got %v", result.Config["bootstrap_servers"])
+	}
+}
+
+func TestUpdateConnector(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("expected PATCH request, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/target-connectors/connector-123" {
+			t.Errorf("expected path /target-connectors/connector-123, got %s", r.URL.Path)
+		}
+
+		var connector ConnectorUpdate
+		if err := json.NewDecoder(r.Body).Decode(&connector); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+
+		if connector.Name != nil {
+			t.Errorf("expected no name update, got %s", *connector.Name)
+		}
+
+		response := ConnectorRead{
+			ID:       "connector-123",
+			Name:     "warehouse",
+			Type:     "ORACLE_TARGET",
+			Config:   *connector.Config,
+			Revision: 2,
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	config := map[string]any{"host": "oracle.example.com"}
+	result, err := client.UpdateConnector(context.Background(), ConnectorKindTarget, "connector-123", &ConnectorUpdate{
+		Config: &config,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Revision != 2 {
+		t.Errorf("expected Revision 2, got %d", result.Revision)
+	}
+}
+
+func TestGetConnector_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	result, err := client.GetConnector(context.Background(), ConnectorKindSource, "nonexistent")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result != nil {
+		t.Errorf("expected nil result for not found, got %v", result)
+	}
+}
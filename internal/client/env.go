@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 )
 
 // BrokerConfiguration represents the retention configuration for an environment
@@ -33,6 +34,11 @@ type EnvUpdate struct {
 	RetentionConfiguration *BrokerConfiguration `json:"retention_configuration,omitempty"`
 }
 
+// EnvFilter represents the filters of an environment list request
+type EnvFilter struct {
+	Name string
+}
+
 // CreateEnv creates a new environment
 func (c *Client) CreateEnv(ctx context.Context, env *EnvCreate) (*EnvRead, error) {
 	resp, err := c.doRequest(ctx, http.MethodPost, "/envs/", env)
@@ -87,6 +93,16 @@ func (c *Client) GetEnv(ctx context.Context, envID string) (*EnvRead, error) {
 	return &result, nil
 }
 
+// ListEnvs retrieves all environments matching the filter
+func (c *Client) ListEnvs(ctx context.Context, filter EnvFilter) ([]EnvRead, error) {
+	query := url.Values{}
+	if filter.Name != "" {
+		query.Set("name", filter.Name)
+	}
+
+	return listAll[EnvRead](ctx, c, "/envs/", query)
+}
+
 // UpdateEnv updates an existing environment
 func (c *Client) UpdateEnv(ctx context.Context, envID string, env *EnvUpdate) (*EnvRead, error) {
 	resp, err := c.doRequest(ctx, http.MethodPatch, "/envs/"+envID, env)
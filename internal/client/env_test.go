@@ -103,6 +103,42 @@ func TestGetEnv_NotFound(t *testing.T) {
 	}
 }
 
+func TestListEnvs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/envs/" {
+			t.Errorf("expected path /envs/, got %s", r.URL.Path)
+		}
+
+		if r.URL.Query().Get("name") != "production" {
+			t.Errorf("expected name filter production, got %s", r.URL.Query().Get("name"))
+		}
+
+		response := Page[EnvRead]{
+			Items: []EnvRead{
+				{ID: "env-123", Name: "production", UseRetention: false},
+			},
+			Total: 1,
+			Page:  1,
+			Size:  100,
+			Pages: 1,
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	result, err := client.ListEnvs(context.Background(), EnvFilter{Name: "production"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result) != 1 || result[0].ID != "env-123" {
+		t.Errorf("expected env-123, got %v", result)
+	}
+}
+
 func TestUpdateEnv(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPatch {
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// IdentityRead represents the principal the API token authenticates as
+type IdentityRead struct {
+	OrganizationID   string   `json:"organization_id"`
+	OrganizationName string   `json:"organization_name"`
+	PrincipalType    string   `json:"principal_type"`
+	PrincipalID      string   `json:"principal_id"`
+	Email            *string  `json:"email,omitempty"`
+	Scopes           []string `json:"scopes"`
+}
+
+// GetCurrentIdentity retrieves the principal and organization of the configured API token
+func (c *Client) GetCurrentIdentity(ctx context.Context) (*IdentityRead, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/me", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result IdentityRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// OrganizationID returns the ID of the organization of the configured API token. The identity
+// is only requested once, so resources can record their organization without extra requests.
+func (c *Client) OrganizationID(ctx context.Context) (string, error) {
+	c.organizationMu.Lock()
+	defer c.organizationMu.Unlock()
+
+	if c.organizationID != "" {
+		return c.organizationID, nil
+	}
+
+	identity, err := c.GetCurrentIdentity(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	c.organizationID = identity.OrganizationID
+	return c.organizationID, nil
+}
@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetCurrentIdentity(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET request, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/me" {
+			t.Errorf("expected path /me, got %s", r.URL.Path)
+		}
+
+		response := IdentityRead{
+			OrganizationID:   "org-123",
+			OrganizationName: "Acme",
+			PrincipalType:    "service_account",
+			PrincipalID:      "sa-123",
+			Scopes:           []string{"pipelines:read", "pipelines:write"},
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	result, err := client.GetCurrentIdentity(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.OrganizationID != "org-123" {
+		t.Errorf("expected organization ID org-123, got %s", result.OrganizationID)
+	}
+
+	if result.PrincipalType != "service_account" || result.Email != nil {
+		t.Errorf("expected a service account without email, got %s %v", result.PrincipalType, result.Email)
+	}
+
+	if len(result.Scopes) != 2 {
+		t.Errorf("expected 2 scopes, got %v", result.Scopes)
+	}
+}
+
+func TestOrganizationID(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(IdentityRead{OrganizationID: "org-123"})
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	for range 3 {
+		orgID, err := client.OrganizationID(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if orgID != "org-123" {
+			t.Errorf("expected organization ID org-123, got %s", orgID)
+		}
+	}
+
+	if requests != 1 {
+		t.Errorf("expected the identity to be requested once, got %d requests", requests)
+	}
+}
@@ -0,0 +1,140 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// NotificationChannelCreate represents the request to create a notification channel.
+// Which fields are used depends on the channel type.
+type NotificationChannelCreate struct {
+	Name       string            `json:"name"`
+	Type       string            `json:"type"`
+	URL        *string           `json:"url,omitempty"`
+	Emails     []string          `json:"emails,omitempty"`
+	RoutingKey *string           `json:"routing_key,omitempty"`
+	Headers    map[string]string `json:"headers,omitempty"`
+}
+
+// NotificationChannelUpdate represents the request to update a notification channel
+type NotificationChannelUpdate struct {
+	Name       *string            `json:"name,omitempty"`
+	URL        *string            `json:"url,omitempty"`
+	Emails     *[]string          `json:"emails,omitempty"`
+	RoutingKey *string            `json:"routing_key,omitempty"`
+	Headers    *map[string]string `json:"headers,omitempty"`
+}
+
+// NotificationChannelRead represents a notification channel response.
+// The routing key and header values are never returned.
+type NotificationChannelRead struct {
+	ID     string   `json:"id"`
+	Name   string   `json:"name"`
+	Type   string   `json:"type"`
+	URL    *string  `json:"url"`
+	Emails []string `json:"emails"`
+}
+
+// CreateNotificationChannel creates a new notification channel
+func (c *Client) CreateNotificationChannel(ctx context.Context, notificationChannel *NotificationChannelCreate) (*NotificationChannelRead, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, "/notification-channels/", notificationChannel)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result NotificationChannelRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetNotificationChannel retrieves a notification channel by ID
+func (c *Client) GetNotificationChannel(ctx context.Context, notificationChannelID string) (*NotificationChannelRead, error) {
+	path := fmt.Sprintf("/notification-channels/%s", notificationChannelID)
+	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result NotificationChannelRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// UpdateNotificationChannel updates an existing notification channel
+func (c *Client) UpdateNotificationChannel(ctx context.Context, notificationChannelID string, notificationChannel *NotificationChannelUpdate) (*NotificationChannelRead, error) {
+	path := fmt.Sprintf("/notification-channels/%s", notificationChannelID)
+	resp, err := c.doRequest(ctx, http.MethodPatch, path, notificationChannel)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result NotificationChannelRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// DeleteNotificationChannel deletes a notification channel by ID
+func (c *Client) DeleteNotificationChannel(ctx context.Context, notificationChannelID string) error {
+	path := fmt.Sprintf("/notification-channels/%s", notificationChannelID)
+	resp, err := c.doRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	if err := checkResponse(resp); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCreateNotificationChannel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST request, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/notification-channels/" {
+			t.Errorf("expected path /notification-channels/, got %s", r.URL.Path)
+		}
+
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+
+		// Fields of other channel types must not be sent
+		if _, ok := body["url"]; ok {
+			t.Errorf("expected no url for email channel, got %v", body)
+		}
+
+		response := NotificationChannelRead{
+			ID:     "channel-123",
+			Name:   "data-oncall",
+			Type:   "email",
+			Emails: []string{"oncall@example.com"},
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	result, err := client.CreateNotificationChannel(context.Background(), &NotificationChannelCreate{
+		Name:   "data-oncall",
+		Type:   "email",
+		Emails: []string{"oncall@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.ID != "channel-123" {
+		t.Errorf("expected ID channel-123, got %s", result.ID)
+	}
+}
+
+func TestGetNotificationChannel_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	result, err := client.GetNotificationChannel(context.Background(), "nonexistent")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result != nil {
+		t.Errorf("expected nil result for not found, got %v", result)
+	}
+}
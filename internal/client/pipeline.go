@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 )
 
 // PipelineState represents the state of a pipeline
@@ -30,6 +31,17 @@ type PipelineConfiguration struct {
 	SMTName      string         `json:"smt_name"`
 	SMTConfig    []any          `json:"smt_config"`
 	DraftStep    string         `json:"draft_step"`
+
+	// Connector references replace the inline source and target configurations
+	SourceConnectorID       *string `json:"source_connector_id,omitempty"`
+	SourceConnectorRevision *int64  `json:"source_connector_revision,omitempty"`
+	TargetConnectorID       *string `json:"target_connector_id,omitempty"`
+	TargetConnectorRevision *int64  `json:"target_connector_revision,omitempty"`
+
+	// Ordered transforms, either inline or through a transform chain reference
+	Transforms        []TransformStep `json:"transforms,omitempty"`
+	TransformID       *string         `json:"transform_id,omitempty"`
+	TransformRevision *int64          `json:"transform_revision,omitempty"`
 }
 
 // PipelineCreate represents the request to create a pipeline
@@ -56,6 +68,19 @@ type PipelineRead struct {
 	TeamID            string                 `json:"team_id"`
 	TeamName          string                 `json:"team_name"`
 	JSONConfiguration *PipelineConfiguration `json:"json_configuration"`
+	LastError         *string                `json:"last_error,omitempty"`
+	StatusUpdatedAt   *string                `json:"status_updated_at,omitempty"`
+	RecordsProcessed  *int64                 `json:"records_processed,omitempty"`
+	ConsumerLag       *int64                 `json:"consumer_lag,omitempty"`
+	CreatedAt         *string                `json:"created_at,omitempty"`
+	UpdatedAt         *string                `json:"updated_at,omitempty"`
+}
+
+// PipelineFilter represents the filters of a pipeline list request
+type PipelineFilter struct {
+	TeamID string
+	Name   string
+	State  PipelineState
 }
 
 // CreatePipeline creates a new pipeline
@@ -113,6 +138,22 @@ func (c *Client) GetPipeline(ctx context.Context, pipelineID string) (*PipelineR
 	return &result, nil
 }
 
+// ListPipelines retrieves all pipelines matching the filter
+func (c *Client) ListPipelines(ctx context.Context, filter PipelineFilter) ([]PipelineRead, error) {
+	query := url.Values{}
+	if filter.TeamID != "" {
+		query.Set("team_id", filter.TeamID)
+	}
+	if filter.Name != "" {
+		query.Set("name", filter.Name)
+	}
+	if filter.State != "" {
+		query.Set("state", string(filter.State))
+	}
+
+	return listAll[PipelineRead](ctx, c, "/pipelines/", query)
+}
+
 // UpdatePipeline updates an existing pipeline
 func (c *Client) UpdatePipeline(ctx context.Context, pipelineID string, pipeline *PipelineUpdate) (*PipelineRead, error) {
 	path := fmt.Sprintf("/pipelines/%s", pipelineID)
@@ -95,6 +95,53 @@ func TestGetPipeline(t *testing.T) {
 	}
 }
 
+func TestGetPipeline_RuntimeStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"id": "pipeline-123",
+			"name": "test-pipeline",
+			"state": "error",
+			"team_id": "team-123",
+			"team_name": "Test Team",
+			"last_error": "connection refused",
+			"status_updated_at": "2025-01-02T03:04:05Z",
+			"records_processed": 42,
+			"consumer_lag": 7,
+			"created_at": "2025-01-01T00:00:00Z",
+			"updated_at": "2025-01-02T03:04:05Z"
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	result, err := client.GetPipeline(context.Background(), "pipeline-123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result == nil {
+		t.Fatal("expected result, got nil")
+		return
+	}
+
+	if result.LastError == nil || *result.LastError != "connection refused" {
+		t.Errorf("expected LastError 'connection refused', got %v", result.LastError)
+	}
+
+	if result.RecordsProcessed == nil || *result.RecordsProcessed != 42 {
+		t.Errorf("expected RecordsProcessed 42, got %v", result.RecordsProcessed)
+	}
+
+	if result.ConsumerLag == nil || *result.ConsumerLag != 7 {
+		t.Errorf("expected ConsumerLag 7, got %v", result.ConsumerLag)
+	}
+
+	if result.CreatedAt == nil || *result.CreatedAt != "2025-01-01T00:00:00Z" {
+		t.Errorf("expected CreatedAt 2025-01-01T00:00:00Z, got %v", result.CreatedAt)
+	}
+}
+
 func TestGetPipeline_NotFound(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusNotFound)
@@ -112,6 +159,70 @@ func TestGetPipeline_NotFound(t *testing.T) {
 	}
 }
 
+func TestListPipelines(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/pipelines/" {
+			t.Errorf("expected path /pipelines/, got %s", r.URL.Path)
+		}
+
+		if r.URL.Query().Get("team_id") != "team-123" || r.URL.Query().Get("name") != "orders" {
+			t.Errorf("expected team_id and name filters, got %s", r.URL.RawQuery)
+		}
+
+		response := Page[PipelineRead]{
+			Items: []PipelineRead{
+				{ID: "pipeline-123", Name: "orders", TeamID: "team-123", State: PipelineStateLive},
+			},
+			Total: 1,
+			Page:  1,
+			Size:  100,
+			Pages: 1,
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	result, err := client.ListPipelines(context.Background(), PipelineFilter{TeamID: "team-123", Name: "orders"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result) != 1 || result[0].ID != "pipeline-123" {
+		t.Errorf("expected pipeline-123, got %v", result)
+	}
+}
+
+func TestListPipelines_StateFilter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("state") != "error" {
+			t.Errorf("expected state filter error, got %s", r.URL.Query().Get("state"))
+		}
+
+		if r.URL.Query().Has("name") {
+			t.Errorf("expected no name filter, got %s", r.URL.Query().Get("name"))
+		}
+
+		response := Page[PipelineRead]{Items: []PipelineRead{}, Total: 0, Page: 1, Size: 100, Pages: 1}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	result, err := client.ListPipelines(context.Background(), PipelineFilter{State: PipelineStateError})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result) != 0 {
+		t.Errorf("expected no pipelines, got %v", result)
+	}
+}
+
 func TestUpdatePipeline(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPatch {
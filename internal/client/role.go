@@ -0,0 +1,237 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Permission represents an entry of the permission catalog
+type Permission struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// RoleCreate represents the request to create a role
+type RoleCreate struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
+// RoleUpdate represents the request to update a role
+type RoleUpdate struct {
+	Name        *string   `json:"name,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	Permissions *[]string `json:"permissions,omitempty"`
+}
+
+// RoleRead represents a role response
+type RoleRead struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
+// RoleBindingCreate represents the request to bind a role to a principal on a scope
+type RoleBindingCreate struct {
+	RoleID        string `json:"role_id"`
+	PrincipalType string `json:"principal_type"`
+	PrincipalID   string `json:"principal_id"`
+	ScopeType     string `json:"scope_type"`
+	ScopeID       string `json:"scope_id"`
+}
+
+// RoleBindingRead represents a role binding response
+type RoleBindingRead struct {
+	ID            string `json:"id"`
+	RoleID        string `json:"role_id"`
+	PrincipalType string `json:"principal_type"`
+	PrincipalID   string `json:"principal_id"`
+	ScopeType     string `json:"scope_type"`
+	ScopeID       string `json:"scope_id"`
+}
+
+// ListPermissions retrieves the catalog of permissions that can be granted by roles
+func (c *Client) ListPermissions(ctx context.Context) ([]Permission, error) {
+	return listAll[Permission](ctx, c, "/permissions/", nil)
+}
+
+// CreateRole creates a new role
+func (c *Client) CreateRole(ctx context.Context, role *RoleCreate) (*RoleRead, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, "/roles/", role)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result RoleRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetRole retrieves a role by ID
+func (c *Client) GetRole(ctx context.Context, roleID string) (*RoleRead, error) {
+	path := fmt.Sprintf("/roles/%s", roleID)
+	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result RoleRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// UpdateRole updates an existing role
+func (c *Client) UpdateRole(ctx context.Context, roleID string, role *RoleUpdate) (*RoleRead, error) {
+	path := fmt.Sprintf("/roles/%s", roleID)
+	resp, err := c.doRequest(ctx, http.MethodPatch, path, role)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result RoleRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// DeleteRole deletes a role by ID
+func (c *Client) DeleteRole(ctx context.Context, roleID string) error {
+	path := fmt.Sprintf("/roles/%s", roleID)
+	resp, err := c.doRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	if err := checkResponse(resp); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CreateRoleBinding creates a new role binding
+func (c *Client) CreateRoleBinding(ctx context.Context, binding *RoleBindingCreate) (*RoleBindingRead, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, "/role-bindings/", binding)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result RoleBindingRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetRoleBinding retrieves a role binding by ID
+func (c *Client) GetRoleBinding(ctx context.Context, bindingID string) (*RoleBindingRead, error) {
+	path := fmt.Sprintf("/role-bindings/%s", bindingID)
+	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result RoleBindingRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// DeleteRoleBinding deletes a role binding by ID
+func (c *Client) DeleteRoleBinding(ctx context.Context, bindingID string) error {
+	path := fmt.Sprintf("/role-bindings/%s", bindingID)
+	resp, err := c.doRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	if err := checkResponse(resp); err != nil {
+		return err
+	}
+
+	return nil
+}
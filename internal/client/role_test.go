@@ -0,0 +1,125 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListPermissions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/permissions/" {
+			t.Errorf("expected path /permissions/, got %s", r.URL.Path)
+		}
+
+		response := Page[Permission]{
+			Items: []Permission{
+				{Name: "pipeline:read", Description: "Read pipelines"},
+				{Name: "pipeline:write", Description: "Create and update pipelines"},
+			},
+			Total: 2,
+			Page:  1,
+			Size:  100,
+			Pages: 1,
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	result, err := client.ListPermissions(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result) != 2 || result[0].Name != "pipeline:read" {
+		t.Errorf("expected permissions [pipeline:read pipeline:write], got %v", result)
+	}
+}
+
+func TestCreateRole(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST request, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/roles/" {
+			t.Errorf("expected path /roles/, got %s", r.URL.Path)
+		}
+
+		var role RoleCreate
+		if err := json.NewDecoder(r.Body).Decode(&role); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+
+		response := RoleRead{
+			ID:          "role-123",
+			Name:        role.Name,
+			Description: role.Description,
+			Permissions: role.Permissions,
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	result, err := client.CreateRole(context.Background(), &RoleCreate{
+		Name:        "read-only",
+		Permissions: []string{"pipeline:read"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.ID != "role-123" {
+		t.Errorf("expected ID role-123, got %s", result.ID)
+	}
+
+	if len(result.Permissions) != 1 || result.Permissions[0] != "pipeline:read" {
+		t.Errorf("expected Permissions [pipeline:read], got %v", result.Permissions)
+	}
+}
+
+func TestGetRoleBinding_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	result, err := client.GetRoleBinding(context.Background(), "nonexistent")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result != nil {
+		t.Errorf("expected nil result for not found, got %v", result)
+	}
+}
+
+func TestDeleteRoleBinding(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected DELETE request, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/role-bindings/binding-123" {
+			t.Errorf("expected path /role-bindings/binding-123, got %s", r.URL.Path)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	err := client.DeleteRoleBinding(context.Background(), "binding-123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
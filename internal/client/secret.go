@@ -0,0 +1,138 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// SecretRefKey is the key of the objects referencing a secret in connector,
+// pipeline and retention configurations. References are resolved by the API.
+const SecretRefKey = "secret_ref"
+
+// SecretCreate represents the request to create a secret
+type SecretCreate struct {
+	Name   string  `json:"name"`
+	Value  string  `json:"value"`
+	EnvID  *string `json:"env_id,omitempty"`
+	TeamID *string `json:"team_id,omitempty"`
+}
+
+// SecretUpdate represents the request to update a secret
+type SecretUpdate struct {
+	Name  *string `json:"name,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
+
+// SecretRead represents a secret response. The secret value is never returned.
+type SecretRead struct {
+	ID        string  `json:"id"`
+	Name      string  `json:"name"`
+	EnvID     *string `json:"env_id"`
+	TeamID    *string `json:"team_id"`
+	Version   int64   `json:"version"`
+	UpdatedAt string  `json:"updated_at"`
+}
+
+// CreateSecret creates a new secret
+func (c *Client) CreateSecret(ctx context.Context, secret *SecretCreate) (*SecretRead, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, "/secrets/", secret)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result SecretRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetSecret retrieves a secret by ID
+func (c *Client) GetSecret(ctx context.Context, secretID string) (*SecretRead, error) {
+	path := fmt.Sprintf("/secrets/%s", secretID)
+	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result SecretRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// UpdateSecret updates an existing secret
+func (c *Client) UpdateSecret(ctx context.Context, secretID string, secret *SecretUpdate) (*SecretRead, error) {
+	path := fmt.Sprintf("/secrets/%s", secretID)
+	resp, err := c.doRequest(ctx, http.MethodPatch, path, secret)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result SecretRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// DeleteSecret deletes a secret by ID
+func (c *Client) DeleteSecret(ctx context.Context, secretID string) error {
+	path := fmt.Sprintf("/secrets/%s", secretID)
+	resp, err := c.doRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	if err := checkResponse(resp); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCreateSecret(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST request, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/secrets/" {
+			t.Errorf("expected path /secrets/, got %s", r.URL.Path)
+		}
+
+		var secret SecretCreate
+		if err := json.NewDecoder(r.Body).Decode(&secret); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+
+		if secret.Value != "s3cr3t" {
+			t.Errorf("expected Value s3cr3t, got %s", secret.Value)
+		}
+
+		response := SecretRead{
+			ID:      "secret-123",
+			Name:    secret.Name,
+			EnvID:   secret.EnvID,
+			Version: 1,
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	envID := "env-123"
+	result, err := client.CreateSecret(context.Background(), &SecretCreate{
+		Name:  "oracle-password",
+		Value: "s3cr3t",
+		EnvID: &envID,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.ID != "secret-123" {
+		t.Errorf("expected ID secret-123, got %s", result.ID)
+	}
+
+	if result.Version != 1 {
+		t.Errorf("expected Version 1, got %d", result.Version)
+	}
+}
+
+func TestUpdateSecret(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("expected PATCH request, got %s", r.Method)
+		}
+
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+
+		if _, ok := body["name"]; ok {
+			t.Errorf("expected no name in request, got %v", body)
+		}
+
+		response := SecretRead{
+			ID:      "secret-123",
+			Name:    "oracle-password",
+			Version: 2,
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	value := "n3w-s3cr3t"
+	result, err := client.UpdateSecret(context.Background(), "secret-123", &SecretUpdate{Value: &value})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Version != 2 {
+		t.Errorf("expected Version 2, got %d", result.Version)
+	}
+}
+
+func TestGetSecret_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	result, err := client.GetSecret(context.Background(), "nonexistent")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result != nil {
+		t.Errorf("expected nil result for not found, got %v", result)
+	}
+}
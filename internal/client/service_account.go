@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// ServiceAccountCreate represents the request to create a service account
+type ServiceAccountCreate struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// ServiceAccountUpdate represents the request to update a service account
+type ServiceAccountUpdate struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// ServiceAccountRead represents a service account response
+type ServiceAccountRead struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// CreateServiceAccount creates a new service account
+func (c *Client) CreateServiceAccount(ctx context.Context, account *ServiceAccountCreate) (*ServiceAccountRead, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, "/service-accounts/", account)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result ServiceAccountRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetServiceAccount retrieves a service account by ID
+func (c *Client) GetServiceAccount(ctx context.Context, accountID string) (*ServiceAccountRead, error) {
+	path := fmt.Sprintf("/service-accounts/%s", accountID)
+	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result ServiceAccountRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// UpdateServiceAccount updates an existing service account
+func (c *Client) UpdateServiceAccount(ctx context.Context, accountID string, account *ServiceAccountUpdate) (*ServiceAccountRead, error) {
+	path := fmt.Sprintf("/service-accounts/%s", accountID)
+	resp, err := c.doRequest(ctx, http.MethodPatch, path, account)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result ServiceAccountRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// DeleteServiceAccount deletes a service account by ID
+func (c *Client) DeleteServiceAccount(ctx context.Context, accountID string) error {
+	path := fmt.Sprintf("/service-accounts/%s", accountID)
+	resp, err := c.doRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	if err := checkResponse(resp); err != nil {
+		return err
+	}
+
+	return nil
+}
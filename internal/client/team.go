@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 )
 
 // TeamCreate represents the request to create a team
@@ -30,6 +31,12 @@ type TeamRead struct {
 	EnvID       *string `json:"env_id"`
 }
 
+// TeamFilter represents the filters of a team list request
+type TeamFilter struct {
+	Name  string
+	EnvID string
+}
+
 // CreateTeam creates a new team
 func (c *Client) CreateTeam(ctx context.Context, team *TeamCreate) (*TeamRead, error) {
 	resp, err := c.doRequest(ctx, http.MethodPost, "/teams/", team)
@@ -85,6 +92,19 @@ func (c *Client) GetTeam(ctx context.Context, teamID string) (*TeamRead, error)
 	return &result, nil
 }
 
+// ListTeams retrieves all teams matching the filter
+func (c *Client) ListTeams(ctx context.Context, filter TeamFilter) ([]TeamRead, error) {
+	query := url.Values{}
+	if filter.Name != "" {
+		query.Set("name", filter.Name)
+	}
+	if filter.EnvID != "" {
+		query.Set("env_id", filter.EnvID)
+	}
+
+	return listAll[TeamRead](ctx, c, "/teams/", query)
+}
+
 // UpdateTeam updates an existing team
 func (c *Client) UpdateTeam(ctx context.Context, teamID string, team *TeamUpdate) (*TeamRead, error) {
 	path := fmt.Sprintf("/teams/%s", teamID)
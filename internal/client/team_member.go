@@ -0,0 +1,138 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// TeamMemberCreate represents the request to add a member to a team.
+// Either UserID or Email identifies the user.
+type TeamMemberCreate struct {
+	UserID *string `json:"user_id,omitempty"`
+	Email  *string `json:"email,omitempty"`
+	Role   string  `json:"role"`
+}
+
+// TeamMemberUpdate represents the request to update a team member
+type TeamMemberUpdate struct {
+	Role *string `json:"role,omitempty"`
+}
+
+// TeamMemberRead represents a team member response
+type TeamMemberRead struct {
+	TeamID string `json:"team_id"`
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+	Role   string `json:"role"`
+}
+
+// ListTeamMembers retrieves all members of a team
+func (c *Client) ListTeamMembers(ctx context.Context, teamID string) ([]TeamMemberRead, error) {
+	path := fmt.Sprintf("/teams/%s/members/", teamID)
+	return listAll[TeamMemberRead](ctx, c, path, nil)
+}
+
+// AddTeamMember adds a user to a team
+func (c *Client) AddTeamMember(ctx context.Context, teamID string, member *TeamMemberCreate) (*TeamMemberRead, error) {
+	path := fmt.Sprintf("/teams/%s/members/", teamID)
+	resp, err := c.doRequest(ctx, http.MethodPost, path, member)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result TeamMemberRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetTeamMember retrieves a team member by user ID
+func (c *Client) GetTeamMember(ctx context.Context, teamID, userID string) (*TeamMemberRead, error) {
+	path := fmt.Sprintf("/teams/%s/members/%s", teamID, userID)
+	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result TeamMemberRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// UpdateTeamMember updates the role of a team member
+func (c *Client) UpdateTeamMember(ctx context.Context, teamID, userID string, member *TeamMemberUpdate) (*TeamMemberRead, error) {
+	path := fmt.Sprintf("/teams/%s/members/%s", teamID, userID)
+	resp, err := c.doRequest(ctx, http.MethodPatch, path, member)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result TeamMemberRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// RemoveTeamMember removes a user from a team
+func (c *Client) RemoveTeamMember(ctx context.Context, teamID, userID string) error {
+	path := fmt.Sprintf("/teams/%s/members/%s", teamID, userID)
+	resp, err := c.doRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	if err := checkResponse(resp); err != nil {
+		return err
+	}
+
+	return nil
+}
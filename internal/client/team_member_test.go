@@ -0,0 +1,156 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAddTeamMember(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST request, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/teams/team-123/members/" {
+			t.Errorf("expected path /teams/team-123/members/, got %s", r.URL.Path)
+		}
+
+		var member TeamMemberCreate
+		if err := json.NewDecoder(r.Body).Decode(&member); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+
+		if member.Email == nil || *member.Email != "jane@example.com" {
+			t.Errorf("expected Email jane@example.com, got %v", member.Email)
+		}
+
+		response := TeamMemberRead{
+			TeamID: "team-123",
+			UserID: "user-123",
+			Email:  "jane@example.com",
+			Role:   member.Role,
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	email := "jane@example.com"
+	result, err := client.AddTeamMember(context.Background(), "team-123", &TeamMemberCreate{
+		Email: &email,
+		Role:  "member",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.UserID != "user-123" {
+		t.Errorf("expected UserID user-123, got %s", result.UserID)
+	}
+
+	if result.Role != "member" {
+		t.Errorf("expected Role member, got %s", result.Role)
+	}
+}
+
+func TestListTeamMembers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET request, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/teams/team-123/members/" {
+			t.Errorf("expected path /teams/team-123/members/, got %s", r.URL.Path)
+		}
+
+		response := Page[TeamMemberRead]{
+			Items: []TeamMemberRead{
+				{TeamID: "team-123", UserID: "user-1", Email: "a@example.com", Role: "admin"},
+				{TeamID: "team-123", UserID: "user-2", Email: "b@example.com", Role: "viewer"},
+			},
+			Total: 2,
+			Page:  1,
+			Size:  100,
+			Pages: 1,
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	result, err := client.ListTeamMembers(context.Background(), "team-123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result) != 2 {
+		t.Fatalf("expected 2 members, got %d", len(result))
+	}
+
+	if result[1].Role != "viewer" {
+		t.Errorf("expected Role viewer, got %s", result[1].Role)
+	}
+}
+
+func TestGetTeamMember_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	result, err := client.GetTeamMember(context.Background(), "team-123", "nonexistent")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result != nil {
+		t.Errorf("expected nil result for not found, got %v", result)
+	}
+}
+
+func TestListTeamMembers_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"detail":"Team not found"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	_, err := client.ListTeamMembers(context.Background(), "nonexistent")
+	if !IsNotFound(err) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+
+	if err.Error() != `API request failed with status 404: {"detail":"Team not found"}` {
+		t.Errorf("unexpected error message: %s", err.Error())
+	}
+}
+
+func TestRemoveTeamMember(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected DELETE request, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/teams/team-123/members/user-123" {
+			t.Errorf("expected path /teams/team-123/members/user-123, got %s", r.URL.Path)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	err := client.RemoveTeamMember(context.Background(), "team-123", "user-123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -45,6 +45,43 @@ func TestCreateTeam(t *testing.T) {
 	}
 }
 
+func TestListTeams(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/teams/" {
+			t.Errorf("expected path /teams/, got %s", r.URL.Path)
+		}
+
+		if r.URL.Query().Get("name") != "data-team" || r.URL.Query().Get("env_id") != "env-123" {
+			t.Errorf("expected name and env_id filters, got %s", r.URL.RawQuery)
+		}
+
+		envID := "env-123"
+		response := Page[TeamRead]{
+			Items: []TeamRead{
+				{ID: "team-123", Name: "data-team", EnvID: &envID},
+			},
+			Total: 1,
+			Page:  1,
+			Size:  100,
+			Pages: 1,
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	result, err := client.ListTeams(context.Background(), TeamFilter{Name: "data-team", EnvID: "env-123"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result) != 1 || result[0].ID != "team-123" {
+		t.Errorf("expected team-123, got %v", result)
+	}
+}
+
 func TestDeleteTeam(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodDelete {
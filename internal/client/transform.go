@@ -0,0 +1,149 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// TransformStep represents a single message transform. Which fields are
+// used depends on the transform type.
+type TransformStep struct {
+	Type             string  `json:"type"`
+	Condition        *string `json:"condition,omitempty"`
+	Field            *string `json:"field,omitempty"`
+	NewName          *string `json:"new_name,omitempty"`
+	Replacement      *string `json:"replacement,omitempty"`
+	ToType           *string `json:"to_type,omitempty"`
+	TopicRegex       *string `json:"topic_regex,omitempty"`
+	TopicReplacement *string `json:"topic_replacement,omitempty"`
+}
+
+// TransformCreate represents the request to create a transform chain
+type TransformCreate struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	TeamID      *string         `json:"team_id,omitempty"`
+	Steps       []TransformStep `json:"steps"`
+}
+
+// TransformUpdate represents the request to update a transform chain
+type TransformUpdate struct {
+	Name        *string          `json:"name,omitempty"`
+	Description *string          `json:"description,omitempty"`
+	Steps       *[]TransformStep `json:"steps,omitempty"`
+}
+
+// TransformRead represents a transform chain response. Revision is
+// incremented every time the steps change.
+type TransformRead struct {
+	ID          string          `json:"id"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	TeamID      *string         `json:"team_id"`
+	Steps       []TransformStep `json:"steps"`
+	Revision    int64           `json:"revision"`
+}
+
+// CreateTransform creates a new transform chain
+func (c *Client) CreateTransform(ctx context.Context, transform *TransformCreate) (*TransformRead, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, "/transforms/", transform)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result TransformRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetTransform retrieves a transform chain by ID
+func (c *Client) GetTransform(ctx context.Context, transformID string) (*TransformRead, error) {
+	path := fmt.Sprintf("/transforms/%s", transformID)
+	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result TransformRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// UpdateTransform updates an existing transform chain
+func (c *Client) UpdateTransform(ctx context.Context, transformID string, transform *TransformUpdate) (*TransformRead, error) {
+	path := fmt.Sprintf("/transforms/%s", transformID)
+	resp, err := c.doRequest(ctx, http.MethodPatch, path, transform)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result TransformRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// DeleteTransform deletes a transform chain by ID
+func (c *Client) DeleteTransform(ctx context.Context, transformID string) error {
+	path := fmt.Sprintf("/transforms/%s", transformID)
+	resp, err := c.doRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	if err := checkResponse(resp); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCreateTransform(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST request, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/transforms/" {
+			t.Errorf("expected path /transforms/, got %s", r.URL.Path)
+		}
+
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+
+		// Unused step fields must not be sent
+		steps := body["steps"].([]any)
+		mask := steps[1].(map[string]any)
+		if _, ok := mask["condition"]; ok {
+			t.Errorf("expected no condition on mask step, got %v", mask)
+		}
+
+		response := TransformRead{
+			ID:       "transform-123",
+			Name:     "orders",
+			Revision: 1,
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	condition := "value.amount > 0"
+	field := "card_number"
+	result, err := client.CreateTransform(context.Background(), &TransformCreate{
+		Name: "orders",
+		Steps: []TransformStep{
+			{Type: "filter", Condition: &condition},
+			{Type: "mask", Field: &field},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.ID != "transform-123" {
+		t.Errorf("expected ID transform-123, got %s", result.ID)
+	}
+}
+
+func TestGetTransform_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	result, err := client.GetTransform(context.Background(), "nonexistent")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result != nil {
+		t.Errorf("expected nil result for not found, got %v", result)
+	}
+}
@@ -0,0 +1,188 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// UserStatus represents the status of a user account
+type UserStatus string
+
+const (
+	UserStatusActive  UserStatus = "active"
+	UserStatusPending UserStatus = "pending"
+	UserStatusExpired UserStatus = "expired"
+)
+
+// UserInvite represents the request to invite a user to the organization
+type UserInvite struct {
+	Email       string  `json:"email"`
+	DisplayName *string `json:"display_name,omitempty"`
+	Role        string  `json:"role"`
+}
+
+// UserUpdate represents the request to update a user
+type UserUpdate struct {
+	DisplayName *string `json:"display_name,omitempty"`
+	Role        *string `json:"role,omitempty"`
+}
+
+// UserRead represents a user response. Invited users that have not
+// accepted their invitation yet have a pending or expired status.
+type UserRead struct {
+	ID          string     `json:"id"`
+	Email       string     `json:"email"`
+	DisplayName string     `json:"display_name"`
+	Role        string     `json:"role"`
+	Status      UserStatus `json:"status"`
+}
+
+// UserFilter represents the filters supported when listing users
+type UserFilter struct {
+	Email string
+	Role  string
+}
+
+// InviteUser invites a user to the organization
+func (c *Client) InviteUser(ctx context.Context, user *UserInvite) (*UserRead, error) {
+	resp, err := c.doRequest(ctx, http.MethodPost, "/users/", user)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result UserRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// ResendInvitation sends a new invitation to a pending or expired user
+func (c *Client) ResendInvitation(ctx context.Context, userID string) (*UserRead, error) {
+	path := fmt.Sprintf("/users/%s/invitation", userID)
+	resp, err := c.doRequest(ctx, http.MethodPost, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result UserRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetUser retrieves a user by ID
+func (c *Client) GetUser(ctx context.Context, userID string) (*UserRead, error) {
+	path := fmt.Sprintf("/users/%s", userID)
+	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result UserRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// ListUsers retrieves all users matching the filter, including pending invitations
+func (c *Client) ListUsers(ctx context.Context, filter UserFilter) ([]UserRead, error) {
+	query := url.Values{}
+	if filter.Email != "" {
+		query.Set("email", filter.Email)
+	}
+	if filter.Role != "" {
+		query.Set("role", filter.Role)
+	}
+
+	return listAll[UserRead](ctx, c, "/users/", query)
+}
+
+// UpdateUser updates an existing user
+func (c *Client) UpdateUser(ctx context.Context, userID string, user *UserUpdate) (*UserRead, error) {
+	path := fmt.Sprintf("/users/%s", userID)
+	resp, err := c.doRequest(ctx, http.MethodPatch, path, user)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result UserRead
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// DeleteUser removes a user from the organization, revoking any pending invitation
+func (c *Client) DeleteUser(ctx context.Context, userID string) error {
+	path := fmt.Sprintf("/users/%s", userID)
+	resp, err := c.doRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	if err := checkResponse(resp); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestInviteUser(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST request, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/users/" {
+			t.Errorf("expected path /users/, got %s", r.URL.Path)
+		}
+
+		response := UserRead{
+			ID:          "user-123",
+			Email:       "jane@example.com",
+			DisplayName: "Jane",
+			Role:        "member",
+			Status:      UserStatusPending,
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	result, err := client.InviteUser(context.Background(), &UserInvite{
+		Email: "jane@example.com",
+		Role:  "member",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.ID != "user-123" {
+		t.Errorf("expected ID user-123, got %s", result.ID)
+	}
+
+	if result.Status != UserStatusPending {
+		t.Errorf("expected Status pending, got %s", result.Status)
+	}
+}
+
+func TestListUsers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users/" {
+			t.Errorf("expected path /users/, got %s", r.URL.Path)
+		}
+
+		if r.URL.Query().Get("email") != "jane@example.com" {
+			t.Errorf("expected email filter jane@example.com, got %s", r.URL.Query().Get("email"))
+		}
+
+		response := Page[UserRead]{
+			Items: []UserRead{
+				{ID: "user-123", Email: "jane@example.com", Role: "admin", Status: UserStatusActive},
+			},
+			Total: 1,
+			Page:  1,
+			Size:  100,
+			Pages: 1,
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	result, err := client.ListUsers(context.Background(), UserFilter{Email: "jane@example.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result) != 1 || result[0].ID != "user-123" {
+		t.Errorf("expected user-123, got %v", result)
+	}
+}
+
+func TestResendInvitation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST request, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/users/user-123/invitation" {
+			t.Errorf("expected path /users/user-123/invitation, got %s", r.URL.Path)
+		}
+
+		response := UserRead{
+			ID:     "user-123",
+			Email:  "jane@example.com",
+			Role:   "member",
+			Status: UserStatusPending,
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	result, err := client.ResendInvitation(context.Background(), "user-123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Status != UserStatusPending {
+		t.Errorf("expected Status pending, got %s", result.Status)
+	}
+}
+
+func TestGetUser_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	result, err := client.GetUser(context.Background(), "nonexistent")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result != nil {
+		t.Errorf("expected nil result for not found, got %v", result)
+	}
+}
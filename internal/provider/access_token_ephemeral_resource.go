@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+// defaultAccessTokenTTL is the lifetime of access tokens without a configured ttl
+const defaultAccessTokenTTL = 15 * time.Minute
+
+// accessTokenPrivateKey is the private data key holding the ID of the token to revoke on close
+const accessTokenPrivateKey = "access_token_id"
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ ephemeral.EphemeralResource              = &accessTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &accessTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &accessTokenEphemeralResource{}
+)
+
+// NewAccessTokenEphemeralResource creates a new access token ephemeral resource
+func NewAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &accessTokenEphemeralResource{}
+}
+
+// accessTokenEphemeralResource defines the ephemeral resource implementation
+type accessTokenEphemeralResource struct {
+	client *client.Client
+}
+
+// accessTokenEphemeralResourceModel describes the ephemeral resource data model
+type accessTokenEphemeralResourceModel struct {
+	Scopes    types.Set    `tfsdk:"scopes"`
+	TTL       types.String `tfsdk:"ttl"`
+	ID        types.String `tfsdk:"id"`
+	Token     types.String `tfsdk:"token"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+}
+
+// Metadata returns the ephemeral resource type name
+func (e *accessTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_token"
+}
+
+// Schema defines the ephemeral resource schema
+func (e *accessTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Mints a short-lived Popsink access token for the duration of a Terraform run. " +
+			"The token is never stored in the state or plan and is revoked when Terraform no longer needs it.",
+		Attributes: map[string]schema.Attribute{
+			"scopes": schema.SetAttribute{
+				Description: "The permissions granted to the token. They must be a subset of the permissions of the provider credentials.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"ttl": schema.StringAttribute{
+				Description: "The lifetime of the token as a Go duration, for example 30m. Defaults to 15m.",
+				Optional:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the access token.",
+				Computed:    true,
+			},
+			"token": schema.StringAttribute{
+				Description: "The access token value.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "The RFC 3339 timestamp after which the token is rejected.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the ephemeral resource
+func (e *accessTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = client
+}
+
+// Open mints the access token
+func (e *accessTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config accessTokenEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ttl := defaultAccessTokenTTL
+	if !config.TTL.IsNull() {
+		parsed, err := time.ParseDuration(config.TTL.ValueString())
+		if err != nil || parsed < time.Second {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ttl"),
+				"Invalid Access Token TTL",
+				fmt.Sprintf("ttl must be a positive duration of at least one second such as 30m, got: %s", config.TTL.ValueString()),
+			)
+			return
+		}
+		ttl = parsed
+	}
+
+	var scopes []string
+	resp.Diagnostics.Append(config.Scopes.ElementsAs(ctx, &scopes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := e.client.CreateAccessToken(ctx, &client.AccessTokenCreate{
+		Scopes:     scopes,
+		TTLSeconds: int64(ttl / time.Second),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Access Token",
+			fmt.Sprintf("Could not create access token: %s", err.Error()),
+		)
+		return
+	}
+
+	// Remember the token so that it can be revoked on close
+	tokenID, err := json.Marshal(token.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Access Token",
+			fmt.Sprintf("Could not encode the access token ID: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, accessTokenPrivateKey, tokenID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.ID = types.StringValue(token.ID)
+	config.Token = types.StringValue(token.Token)
+	config.ExpiresAt = types.StringValue(token.ExpiresAt)
+
+	tflog.Info(ctx, "Created access token", map[string]any{"id": token.ID})
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+}
+
+// Close revokes the access token
+func (e *accessTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	value, diags := req.Private.GetKey(ctx, accessTokenPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || len(value) == 0 {
+		return
+	}
+
+	var tokenID string
+	if err := json.Unmarshal(value, &tokenID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Revoking Access Token",
+			fmt.Sprintf("Could not decode the access token ID: %s. Please report this issue to the provider developers.", err.Error()),
+		)
+		return
+	}
+
+	if err := e.client.RevokeAccessToken(ctx, tokenID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Revoking Access Token",
+			fmt.Sprintf("Could not revoke access token %s: %s", tokenID, err.Error()),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Revoked access token", map[string]any{"id": tokenID})
+}
@@ -0,0 +1,427 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+// Valid alert rule metrics
+var validAlertMetrics = []string{
+	"state",
+	"consumer_lag",
+	"records_processed",
+}
+
+// Valid alert rule comparison operators
+var validAlertOperators = []string{
+	"eq",
+	"ne",
+	"gt",
+	"gte",
+	"lt",
+	"lte",
+}
+
+// alertConditionValidator validates that the operator and value of an alert
+// rule make sense for its metric
+type alertConditionValidator struct{}
+
+// Description returns a description of the validator
+func (v alertConditionValidator) Description(_ context.Context) string {
+	return "validates that the operator and value of the alert rule match its metric"
+}
+
+// MarkdownDescription returns a markdown description of the validator
+func (v alertConditionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateResource performs the validation
+func (v alertConditionValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var metric, operator, value types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("metric"), &metric)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("operator"), &operator)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("value"), &value)...)
+	if resp.Diagnostics.HasError() || metric.IsNull() || metric.IsUnknown() {
+		return
+	}
+
+	if metric.ValueString() == "state" {
+		if !operator.IsNull() && !operator.IsUnknown() && !slices.Contains([]string{"eq", "ne"}, operator.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("operator"),
+				"Invalid Alert Condition",
+				fmt.Sprintf("The state metric can only be compared with eq or ne, got %q.", operator.ValueString()),
+			)
+		}
+		if !value.IsNull() && !value.IsUnknown() && !slices.Contains(validPipelineStates, value.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("value"),
+				"Invalid Alert Condition",
+				fmt.Sprintf("The state metric must be compared to a pipeline state. Valid values: %v, got %q.", validPipelineStates, value.ValueString()),
+			)
+		}
+		return
+	}
+
+	if !value.IsNull() && !value.IsUnknown() {
+		if _, err := strconv.ParseFloat(value.ValueString(), 64); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("value"),
+				"Invalid Alert Condition",
+				fmt.Sprintf("The %s metric must be compared to a number, got %q.", metric.ValueString(), value.ValueString()),
+			)
+		}
+	}
+}
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ resource.Resource                     = &alertRuleResource{}
+	_ resource.ResourceWithConfigure        = &alertRuleResource{}
+	_ resource.ResourceWithConfigValidators = &alertRuleResource{}
+	_ resource.ResourceWithImportState      = &alertRuleResource{}
+)
+
+// NewAlertRuleResource creates a new alert rule resource
+func NewAlertRuleResource() resource.Resource {
+	return &alertRuleResource{}
+}
+
+// alertRuleResource defines the resource implementation
+type alertRuleResource struct {
+	client *client.Client
+}
+
+// alertRuleResourceModel describes the resource data model
+type alertRuleResourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	Enabled                types.Bool   `tfsdk:"enabled"`
+	ScopeType              types.String `tfsdk:"scope_type"`
+	ScopeID                types.String `tfsdk:"scope_id"`
+	Metric                 types.String `tfsdk:"metric"`
+	Operator               types.String `tfsdk:"operator"`
+	Value                  types.String `tfsdk:"value"`
+	ForSeconds             types.Int64  `tfsdk:"for_seconds"`
+	NotificationChannelIDs types.Set    `tfsdk:"notification_channel_ids"`
+}
+
+// setAlertRule copies the alert rule returned by the API into the model
+func (m *alertRuleResourceModel) setAlertRule(ctx context.Context, rule *client.AlertRuleRead) diag.Diagnostics {
+	m.ID = types.StringValue(rule.ID)
+	m.Name = types.StringValue(rule.Name)
+	m.Enabled = types.BoolValue(rule.Enabled)
+	m.ScopeType = types.StringValue(rule.ScopeType)
+	m.ScopeID = types.StringValue(rule.ScopeID)
+	m.Metric = types.StringValue(rule.Metric)
+	m.Operator = types.StringValue(rule.Operator)
+	m.Value = types.StringValue(rule.Value)
+	m.ForSeconds = types.Int64Value(rule.ForSeconds)
+	notificationChannelIDs, diags := types.SetValueFrom(ctx, types.StringType, append([]string{}, rule.NotificationChannelIDs...))
+	m.NotificationChannelIDs = notificationChannelIDs
+	return diags
+}
+
+// Metadata returns the resource type name
+func (r *alertRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_rule"
+}
+
+// Schema defines the resource schema
+func (r *alertRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Popsink alert rule. The rule notifies its notification channels when a metric " +
+			"of a pipeline, environment or team matches its condition for a given duration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the alert rule.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the alert rule.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the alert rule is evaluated. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"scope_type": schema.StringAttribute{
+				Description: "The type of the object the alert rule watches. Valid values: env, team, pipeline.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(validScopeTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scope_id": schema.StringAttribute{
+				Description: "The UUID of the environment, team or pipeline the alert rule watches.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"metric": schema.StringAttribute{
+				Description: fmt.Sprintf("The metric the condition applies to. Valid values: %v.", validAlertMetrics),
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(validAlertMetrics...),
+				},
+			},
+			"operator": schema.StringAttribute{
+				Description: fmt.Sprintf("The operator comparing the metric to the value. Valid values: %v. "+
+					"The state metric only supports eq and ne.", validAlertOperators),
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(validAlertOperators...),
+				},
+			},
+			"value": schema.StringAttribute{
+				Description: "The value the metric is compared to: a pipeline state for the state metric, a number otherwise.",
+				Required:    true,
+			},
+			"for_seconds": schema.Int64Attribute{
+				Description: "How long, in seconds, the condition must hold before the alert fires. Defaults to 0.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"notification_channel_ids": schema.SetAttribute{
+				Description: "The UUIDs of the notification channels notified when the alert fires.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource
+func (r *alertRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ConfigValidators returns the resource-level configuration validators
+func (r *alertRuleResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		alertConditionValidator{},
+	}
+}
+
+// Create creates the resource
+func (r *alertRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan alertRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var channelIDs []string
+	resp.Diagnostics.Append(plan.NotificationChannelIDs.ElementsAs(ctx, &channelIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create alert rule
+	createReq := &client.AlertRuleCreate{
+		Name:                   plan.Name.ValueString(),
+		Enabled:                plan.Enabled.ValueBool(),
+		ScopeType:              plan.ScopeType.ValueString(),
+		ScopeID:                plan.ScopeID.ValueString(),
+		Metric:                 plan.Metric.ValueString(),
+		Operator:               plan.Operator.ValueString(),
+		Value:                  plan.Value.ValueString(),
+		ForSeconds:             plan.ForSeconds.ValueInt64(),
+		NotificationChannelIDs: channelIDs,
+	}
+
+	rule, err := r.client.CreateAlertRule(ctx, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Alert Rule",
+			fmt.Sprintf("Could not create alert rule: %s", err.Error()),
+		)
+		return
+	}
+
+	// Update state with created alert rule
+	resp.Diagnostics.Append(plan.setAlertRule(ctx, rule)...)
+
+	tflog.Info(ctx, "Created alert rule", map[string]any{"id": rule.ID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the resource state
+func (r *alertRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state alertRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.GetAlertRule(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Alert Rule",
+			fmt.Sprintf("Could not read alert rule %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// If alert rule not found, remove from state
+	if rule == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Update state
+	resp.Diagnostics.Append(state.setAlertRule(ctx, rule)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource
+func (r *alertRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan alertRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state alertRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build update request
+	updateReq := &client.AlertRuleUpdate{}
+
+	if !plan.Name.Equal(state.Name) {
+		name := plan.Name.ValueString()
+		updateReq.Name = &name
+	}
+
+	if !plan.Enabled.Equal(state.Enabled) {
+		enabled := plan.Enabled.ValueBool()
+		updateReq.Enabled = &enabled
+	}
+
+	if !plan.Metric.Equal(state.Metric) {
+		metric := plan.Metric.ValueString()
+		updateReq.Metric = &metric
+	}
+
+	if !plan.Operator.Equal(state.Operator) {
+		operator := plan.Operator.ValueString()
+		updateReq.Operator = &operator
+	}
+
+	if !plan.Value.Equal(state.Value) {
+		value := plan.Value.ValueString()
+		updateReq.Value = &value
+	}
+
+	if !plan.ForSeconds.Equal(state.ForSeconds) {
+		forSeconds := plan.ForSeconds.ValueInt64()
+		updateReq.ForSeconds = &forSeconds
+	}
+
+	if !plan.NotificationChannelIDs.Equal(state.NotificationChannelIDs) {
+		var channelIDs []string
+		resp.Diagnostics.Append(plan.NotificationChannelIDs.ElementsAs(ctx, &channelIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		updateReq.NotificationChannelIDs = &channelIDs
+	}
+
+	// Update alert rule
+	rule, err := r.client.UpdateAlertRule(ctx, state.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Alert Rule",
+			fmt.Sprintf("Could not update alert rule %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// Update state
+	resp.Diagnostics.Append(plan.setAlertRule(ctx, rule)...)
+
+	tflog.Info(ctx, "Updated alert rule", map[string]any{"id": rule.ID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource
+func (r *alertRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state alertRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteAlertRule(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Alert Rule",
+			fmt.Sprintf("Could not delete alert rule %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Deleted alert rule", map[string]any{"id": state.ID.ValueString()})
+}
+
+// ImportState imports the resource state
+func (r *alertRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
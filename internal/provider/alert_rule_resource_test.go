@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAlertConditionValidator(t *testing.T) {
+	tests := []struct {
+		name      string
+		metric    string
+		operator  string
+		value     string
+		wantError bool
+	}{
+		{name: "state equals error", metric: "state", operator: "eq", value: "error", wantError: false},
+		{name: "state compared with gt", metric: "state", operator: "gt", value: "error", wantError: true},
+		{name: "unknown state", metric: "state", operator: "eq", value: "broken", wantError: true},
+		{name: "lag above threshold", metric: "consumer_lag", operator: "gt", value: "10000", wantError: false},
+		{name: "non numeric lag", metric: "consumer_lag", operator: "gt", value: "lots", wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := resourceConfig(t, NewAlertRuleResource(), map[string]tftypes.Value{
+				"metric":   tftypes.NewValue(tftypes.String, tt.metric),
+				"operator": tftypes.NewValue(tftypes.String, tt.operator),
+				"value":    tftypes.NewValue(tftypes.String, tt.value),
+			})
+			req := resource.ValidateConfigRequest{Config: config}
+			resp := &resource.ValidateConfigResponse{}
+
+			alertConditionValidator{}.ValidateResource(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("ValidateResource() diagnostics = %v, wantError %v", resp.Diagnostics, tt.wantError)
+			}
+		})
+	}
+}
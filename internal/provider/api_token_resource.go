@@ -0,0 +1,297 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+// rfc3339Validator validates that a string is an RFC 3339 timestamp
+type rfc3339Validator struct{}
+
+// Description returns a description of the validator
+func (v rfc3339Validator) Description(_ context.Context) string {
+	return "value must be an RFC 3339 timestamp"
+}
+
+// MarkdownDescription returns a markdown description of the validator
+func (v rfc3339Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation
+func (v rfc3339Validator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid Timestamp",
+			fmt.Sprintf("Value must be an RFC 3339 timestamp such as 2030-01-01T00:00:00Z: %s", err.Error()),
+		)
+	}
+}
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ resource.Resource              = &apiTokenResource{}
+	_ resource.ResourceWithConfigure = &apiTokenResource{}
+)
+
+// NewAPITokenResource creates a new API token resource
+func NewAPITokenResource() resource.Resource {
+	return &apiTokenResource{}
+}
+
+// apiTokenResource defines the resource implementation
+type apiTokenResource struct {
+	client *client.Client
+}
+
+// apiTokenResourceModel describes the resource data model
+type apiTokenResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	ServiceAccountID types.String `tfsdk:"service_account_id"`
+	Scopes           types.Set    `tfsdk:"scopes"`
+	ExpiresAt        types.String `tfsdk:"expires_at"`
+	Keepers          types.Map    `tfsdk:"keepers"`
+	Token            types.String `tfsdk:"token"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+}
+
+// Metadata returns the resource type name
+func (r *apiTokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_token"
+}
+
+// Schema defines the resource schema
+func (r *apiTokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Popsink API token. The token value is only returned when the token is created; " +
+			"tokens cannot be updated in place, any change revokes the token and creates a new one.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the API token.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the API token.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"service_account_id": schema.StringAttribute{
+				Description: "The UUID of the service account owning the token. Defaults to the principal the provider is authenticated as.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scopes": schema.SetAttribute{
+				Description: "The permissions granted to the token.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "The RFC 3339 timestamp after which the token is rejected. Tokens without expiry never expire.",
+				Optional:    true,
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"keepers": schema.MapAttribute{
+				Description: "Arbitrary values that, when changed, rotate the token by creating a new one and revoking the old one.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"token": schema.StringAttribute{
+				Description: "The token value. Only known after the token is created.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The RFC 3339 timestamp of the token creation.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource
+func (r *apiTokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource
+func (r *apiTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan apiTokenResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var scopes []string
+	resp.Diagnostics.Append(plan.Scopes.ElementsAs(ctx, &scopes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create API token
+	createReq := &client.APITokenCreate{
+		Name:      plan.Name.ValueString(),
+		Scopes:    scopes,
+		ExpiresAt: plan.ExpiresAt.ValueStringPointer(),
+	}
+
+	// An unknown service account defaults to the principal the provider is authenticated as
+	if !plan.ServiceAccountID.IsUnknown() {
+		createReq.ServiceAccountID = plan.ServiceAccountID.ValueStringPointer()
+	}
+
+	token, err := r.client.CreateAPIToken(ctx, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating API Token",
+			fmt.Sprintf("Could not create API token: %s", err.Error()),
+		)
+		return
+	}
+
+	if token.Token == "" {
+		resp.Diagnostics.AddError(
+			"Missing API Token Value",
+			fmt.Sprintf("The API did not return the value of API token %s. Please report this issue to the provider developers.", token.ID),
+		)
+		return
+	}
+
+	// Update state with created API token. The expiry is kept as configured
+	// since the API may return it in a different but equivalent format.
+	plan.ID = types.StringValue(token.ID)
+	plan.Name = types.StringValue(token.Name)
+	plan.ServiceAccountID = types.StringPointerValue(token.ServiceAccountID)
+	plan.Token = types.StringValue(token.Token)
+	plan.CreatedAt = types.StringValue(token.CreatedAt)
+
+	tflog.Info(ctx, "Created API token", map[string]any{"id": token.ID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the resource state
+func (r *apiTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state apiTokenResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := r.client.GetAPIToken(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading API Token",
+			fmt.Sprintf("Could not read API token %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// If API token was revoked, remove from state so that the next apply creates a new one
+	if token == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Update state. The token value is never returned after creation.
+	state.Name = types.StringValue(token.Name)
+	state.ServiceAccountID = types.StringPointerValue(token.ServiceAccountID)
+	scopes, diags := types.SetValueFrom(ctx, types.StringType, append([]string{}, token.Scopes...))
+	resp.Diagnostics.Append(diags...)
+	state.Scopes = scopes
+	state.CreatedAt = types.StringValue(token.CreatedAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called because every configurable attribute requires replacement
+func (r *apiTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"API Token Update Not Supported",
+		"API tokens cannot be updated in place. Please report this issue to the provider developers.",
+	)
+}
+
+// Delete revokes the API token
+func (r *apiTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state apiTokenResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RevokeAPIToken(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Revoking API Token",
+			fmt.Sprintf("Could not revoke API token %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Revoked API token", map[string]any{"id": state.ID.ValueString()})
+}
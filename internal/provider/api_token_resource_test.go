@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+func TestAPITokenResource_CreateDefaultServiceAccount(t *testing.T) {
+	serviceAccountID := "sa-123"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var token client.APITokenCreate
+		_ = json.NewDecoder(r.Body).Decode(&token)
+		if token.ServiceAccountID != nil {
+			t.Errorf("expected no service account ID, got %s", *token.ServiceAccountID)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(client.APITokenRead{
+			ID:               "token-123",
+			Name:             "ci",
+			ServiceAccountID: &serviceAccountID,
+			Scopes:           token.Scopes,
+			CreatedAt:        "2026-01-01T00:00:00Z",
+			Token:            "secret-value",
+		})
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	r := &apiTokenResource{client: client.NewClient(server.URL, "test-token")}
+
+	// service_account_id is unset in the configuration, so it is unknown in the plan
+	config := resourceConfig(t, r, map[string]tftypes.Value{
+		"id":                 tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"name":               tftypes.NewValue(tftypes.String, "ci"),
+		"service_account_id": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"scopes": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "pipelines:read"),
+		}),
+		"token":      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"created_at": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+	plan := tfsdk.Plan{Schema: config.Schema, Raw: config.Raw}
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: config.Schema, Raw: tftypes.NewValue(config.Raw.Type(), nil)}}
+	r.Create(ctx, resource.CreateRequest{Config: config, Plan: plan}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var state apiTokenResourceModel
+	if diags := resp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if state.ServiceAccountID.ValueString() != serviceAccountID {
+		t.Errorf("expected service_account_id %s, got %s", serviceAccountID, state.ServiceAccountID)
+	}
+}
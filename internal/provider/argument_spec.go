@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// argumentSpec lists the arguments used by one type of a polymorphic object,
+// such as a transform or a notification channel
+type argumentSpec struct {
+	required []string
+	optional []string
+}
+
+// validate reports the required arguments that are missing and the set arguments
+// that are not used. Arguments are checked in the order of names, relative to
+// basePath. object names the kind of object in summaries (e.g. "Transform") and
+// subject names the object type in messages (e.g. "mask transforms").
+func (s argumentSpec) validate(basePath path.Path, object, subject string, names []string, arguments map[string]attr.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	allowed := make(map[string]bool, len(s.required)+len(s.optional))
+	for _, name := range s.required {
+		allowed[name] = true
+	}
+	for _, name := range s.optional {
+		allowed[name] = true
+	}
+
+	for _, name := range s.required {
+		if arguments[name].IsNull() {
+			diags.AddAttributeError(
+				basePath.AtName(name),
+				fmt.Sprintf("Missing %s Argument", object),
+				fmt.Sprintf("%s require %s.", subject, name),
+			)
+		}
+	}
+
+	for _, name := range names {
+		if !arguments[name].IsNull() && !allowed[name] {
+			diags.AddAttributeError(
+				basePath.AtName(name),
+				fmt.Sprintf("Unexpected %s Argument", object),
+				fmt.Sprintf("%s is not used by %s.", name, subject),
+			)
+		}
+	}
+
+	return diags
+}
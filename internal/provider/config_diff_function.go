@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Kinds of configuration changes reported by config_diff
+const (
+	configChangeAdded   = "added"
+	configChangeRemoved = "removed"
+	configChangeChanged = "changed"
+)
+
+// configChangeAttributeTypes are the attribute types of a config_diff change
+var configChangeAttributeTypes = map[string]attr.Type{
+	"path":   types.StringType,
+	"change": types.StringType,
+	"old":    types.StringType,
+	"new":    types.StringType,
+}
+
+// configChange describes a value that differs between two configurations.
+// Old and New hold the JSON encoding of the values, and are nil when absent.
+type configChange struct {
+	Path   string  `tfsdk:"path"`
+	Change string  `tfsdk:"change"`
+	Old    *string `tfsdk:"old"`
+	New    *string `tfsdk:"new"`
+}
+
+// diffConfigValues appends the changes between two decoded JSON values, recursing
+// into objects and arrays, to changes. Object keys are visited in sorted order.
+func diffConfigValues(valuePath string, oldValue, newValue any, changes []configChange) []configChange {
+	oldObject, oldIsObject := oldValue.(map[string]any)
+	newObject, newIsObject := newValue.(map[string]any)
+	if oldIsObject && newIsObject {
+		keys := make([]string, 0, len(oldObject)+len(newObject))
+		for key := range oldObject {
+			keys = append(keys, key)
+		}
+		for key := range newObject {
+			if _, ok := oldObject[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			keyPath := key
+			if valuePath != "" {
+				keyPath = valuePath + "." + key
+			}
+
+			oldNested, inOld := oldObject[key]
+			newNested, inNew := newObject[key]
+			switch {
+			case !inOld:
+				changes = append(changes, configChange{Path: keyPath, Change: configChangeAdded, New: configJSON(newNested)})
+			case !inNew:
+				changes = append(changes, configChange{Path: keyPath, Change: configChangeRemoved, Old: configJSON(oldNested)})
+			default:
+				changes = diffConfigValues(keyPath, oldNested, newNested, changes)
+			}
+		}
+		return changes
+	}
+
+	oldArray, oldIsArray := oldValue.([]any)
+	newArray, newIsArray := newValue.([]any)
+	if oldIsArray && newIsArray {
+		for i := 0; i < max(len(oldArray), len(newArray)); i++ {
+			indexPath := fmt.Sprintf("%s[%d]", valuePath, i)
+			switch {
+			case i >= len(oldArray):
+				changes = append(changes, configChange{Path: indexPath, Change: configChangeAdded, New: configJSON(newArray[i])})
+			case i >= len(newArray):
+				changes = append(changes, configChange{Path: indexPath, Change: configChangeRemoved, Old: configJSON(oldArray[i])})
+			default:
+				changes = diffConfigValues(indexPath, oldArray[i], newArray[i], changes)
+			}
+		}
+		return changes
+	}
+
+	if !reflect.DeepEqual(oldValue, newValue) {
+		changes = append(changes, configChange{Path: valuePath, Change: configChangeChanged, Old: configJSON(oldValue), New: configJSON(newValue)})
+	}
+	return changes
+}
+
+// configJSON returns the JSON encoding of a decoded JSON value
+func configJSON(value any) *string {
+	// Values decoded from JSON can always be encoded again
+	encoded, _ := json.Marshal(value)
+	result := string(encoded)
+	return &result
+}
+
+// Ensure the implementation satisfies the expected interfaces
+var _ function.Function = &configDiffFunction{}
+
+// NewConfigDiffFunction creates a new config_diff function
+func NewConfigDiffFunction() function.Function {
+	return &configDiffFunction{}
+}
+
+// configDiffFunction defines the function implementation
+type configDiffFunction struct{}
+
+// Metadata returns the function name
+func (f *configDiffFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "config_diff"
+}
+
+// Definition defines the function parameters and return type
+func (f *configDiffFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Compares two Popsink JSON configurations",
+		Description: "Normalizes two JSON configurations like normalize_config and returns the list of values that differ, " +
+			"ordered by path. Each change has a path such as source_config.topic or smt_config[0].function_type, " +
+			"a change kind (added, removed or changed), and the JSON encoding of the old and new values.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "a",
+				Description: "The JSON configuration to compare from.",
+			},
+			function.StringParameter{
+				Name:        "b",
+				Description: "The JSON configuration to compare to.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{AttrTypes: configChangeAttributeTypes},
+		},
+	}
+}
+
+// Run compares the configurations
+func (f *configDiffFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var a, b string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &a, &b))
+	if resp.Error != nil {
+		return
+	}
+
+	oldValue, err := parseNormalizedConfig(a)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("Could not parse a as JSON: %s", err.Error())))
+	}
+
+	newValue, err := parseNormalizedConfig(b)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, fmt.Sprintf("Could not parse b as JSON: %s", err.Error())))
+	}
+
+	if resp.Error != nil {
+		return
+	}
+
+	changes := diffConfigValues("", oldValue, newValue, []configChange{})
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, changes))
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNormalizeConfigFunction(t *testing.T) {
+	result, funcErr := runFunction(t, NewNormalizeConfigFunction(),
+		types.StringValue(`{"target_config":{"port":1521,"password":null,"host":"oracle"},"smt_name":"","smt_config":[{"a":"","b":1}]}`),
+	)
+	if funcErr != nil {
+		t.Fatalf("unexpected error: %v", funcErr)
+	}
+
+	want := `{"smt_config":[{"b":1}],"target_config":{"host":"oracle","port":1521}}`
+	if result != want {
+		t.Errorf("normalize_config() = %s, want %s", result, want)
+	}
+}
+
+func TestConfigDiffFunction(t *testing.T) {
+	a := `{"source_config":{"topic":"orders","consumer_group":"staging"},"smt_config":[{"type":"mask"}],"target_name":""}`
+	b := `{"source_config":{"topic":"orders","consumer_group":"production","batch_size":100},"smt_config":[]}`
+
+	resp := &function.RunResponse{
+		Result: function.NewResultData(types.ListUnknown(types.ObjectType{AttrTypes: configChangeAttributeTypes})),
+	}
+	req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(a), types.StringValue(b)})}
+	NewConfigDiffFunction().Run(context.Background(), req, resp)
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %v", resp.Error)
+	}
+
+	var got []configChange
+	if diags := resp.Result.Value().(types.List).ElementsAs(context.Background(), &got, false); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	stringPointer := func(value string) *string { return &value }
+	want := []configChange{
+		{Path: "smt_config[0]", Change: configChangeRemoved, Old: stringPointer(`{"type":"mask"}`)},
+		{Path: "source_config.batch_size", Change: configChangeAdded, New: stringPointer(`100`)},
+		{Path: "source_config.consumer_group", Change: configChangeChanged, Old: stringPointer(`"staging"`), New: stringPointer(`"production"`)},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("config_diff() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,377 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+// Valid source connector types
+var validSourceConnectorTypes = []string{
+	"KAFKA_SOURCE",
+}
+
+// Valid target connector types
+var validTargetConnectorTypes = []string{
+	"ORACLE_TARGET",
+}
+
+// jsonObjectValidator validates that a string is a JSON object
+type jsonObjectValidator struct{}
+
+// Description returns a description of the validator
+func (v jsonObjectValidator) Description(_ context.Context) string {
+	return "value must be a JSON object"
+}
+
+// MarkdownDescription returns a markdown description of the validator
+func (v jsonObjectValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation
+func (v jsonObjectValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var config map[string]any
+	if err := json.Unmarshal([]byte(request.ConfigValue.ValueString()), &config); err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid JSON",
+			fmt.Sprintf("Value must be a JSON object: %s", err.Error()),
+		)
+	}
+}
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ resource.Resource                = &connectorResource{}
+	_ resource.ResourceWithConfigure   = &connectorResource{}
+	_ resource.ResourceWithModifyPlan  = &connectorResource{}
+	_ resource.ResourceWithImportState = &connectorResource{}
+)
+
+// NewSourceConnectorResource creates a new source connector resource
+func NewSourceConnectorResource() resource.Resource {
+	return &connectorResource{
+		kind:       client.ConnectorKindSource,
+		validTypes: validSourceConnectorTypes,
+	}
+}
+
+// NewTargetConnectorResource creates a new target connector resource
+func NewTargetConnectorResource() resource.Resource {
+	return &connectorResource{
+		kind:       client.ConnectorKindTarget,
+		validTypes: validTargetConnectorTypes,
+	}
+}
+
+// connectorResource defines the resource implementation shared by source and target connectors
+type connectorResource struct {
+	client     *client.Client
+	kind       client.ConnectorKind
+	validTypes []string
+}
+
+// connectorResourceModel describes the resource data model
+type connectorResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Type     types.String `tfsdk:"type"`
+	TeamID   types.String `tfsdk:"team_id"`
+	EnvID    types.String `tfsdk:"env_id"`
+	Config   types.String `tfsdk:"config"`
+	Revision types.Int64  `tfsdk:"revision"`
+}
+
+// Metadata returns the resource type name
+func (r *connectorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_%s_connector", req.ProviderTypeName, r.kind)
+}
+
+// Schema defines the resource schema
+func (r *connectorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Manages a reusable Popsink %s connector that pipelines can reference instead of inlining its configuration.", r.kind),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the connector.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the connector.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: fmt.Sprintf("The type of the connector. Valid values: %v.", r.validTypes),
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(r.validTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				Description: "The UUID of the team the connector is restricted to. Connectors without team are shared by all teams.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"env_id": schema.StringAttribute{
+				Description: "The UUID of the environment the connector is restricted to. Connectors without environment are available in all environments.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"config": schema.StringAttribute{
+				Description: "The connector configuration as a JSON object, in the same format as the source_config or target_config of a pipeline.",
+				Required:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					jsonObjectValidator{},
+				},
+			},
+			"revision": schema.Int64Attribute{
+				Description: "The revision of the connector configuration, incremented by every configuration change. " +
+					"Reference it from pipelines so that they are redeployed when the connector changes.",
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource
+func (r *connectorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan keeps the revision known unless the configuration changes, so that
+// pipelines referencing it only show a change when the connector configuration does
+func (r *connectorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state connectorResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Config.IsUnknown() || !plan.Config.Equal(state.Config) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("revision"), state.Revision)...)
+}
+
+// Create creates the resource
+func (r *connectorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan connectorResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var config map[string]any
+	if err := json.Unmarshal([]byte(plan.Config.ValueString()), &config); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid JSON Configuration",
+			fmt.Sprintf("Could not parse config: %s", err.Error()),
+		)
+		return
+	}
+
+	// Create connector
+	createReq := &client.ConnectorCreate{
+		Name:   plan.Name.ValueString(),
+		Type:   plan.Type.ValueString(),
+		TeamID: plan.TeamID.ValueStringPointer(),
+		EnvID:  plan.EnvID.ValueStringPointer(),
+		Config: config,
+	}
+
+	connector, err := r.client.CreateConnector(ctx, r.kind, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Connector",
+			fmt.Sprintf("Could not create %s connector: %s", r.kind, err.Error()),
+		)
+		return
+	}
+
+	// Update state with created connector. The configuration is kept from the
+	// plan since the API redacts secret values.
+	plan.ID = types.StringValue(connector.ID)
+	plan.Name = types.StringValue(connector.Name)
+	plan.Type = types.StringValue(connector.Type)
+	plan.Revision = types.Int64Value(connector.Revision)
+
+	tflog.Info(ctx, "Created connector", map[string]any{"id": connector.ID, "kind": string(r.kind)})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the resource state
+func (r *connectorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state connectorResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connector, err := r.client.GetConnector(ctx, r.kind, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Connector",
+			fmt.Sprintf("Could not read %s connector %s: %s", r.kind, state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// If connector not found, remove from state
+	if connector == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Update state
+	state.Name = types.StringValue(connector.Name)
+	state.Type = types.StringValue(connector.Type)
+	state.TeamID = types.StringPointerValue(connector.TeamID)
+	state.EnvID = types.StringPointerValue(connector.EnvID)
+	state.Revision = types.Int64Value(connector.Revision)
+
+	// Only use the API configuration on import, it does not include secret values
+	if state.Config.IsNull() {
+		config, err := json.Marshal(connector.Config)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Connector",
+				fmt.Sprintf("Could not encode the configuration of %s connector %s: %s", r.kind, connector.ID, err.Error()),
+			)
+			return
+		}
+		state.Config = types.StringValue(string(config))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource
+func (r *connectorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan connectorResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state connectorResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build update request
+	updateReq := &client.ConnectorUpdate{}
+
+	if !plan.Name.Equal(state.Name) {
+		name := plan.Name.ValueString()
+		updateReq.Name = &name
+	}
+
+	if !plan.Config.Equal(state.Config) {
+		var config map[string]any
+		if err := json.Unmarshal([]byte(plan.Config.ValueString()), &config); err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid JSON Configuration",
+				fmt.Sprintf("Could not parse config: %s", err.Error()),
+			)
+			return
+		}
+		updateReq.Config = &config
+	}
+
+	// Update connector
+	connector, err := r.client.UpdateConnector(ctx, r.kind, state.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Connector",
+			fmt.Sprintf("Could not update %s connector %s: %s", r.kind, state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// Update state
+	plan.ID = types.StringValue(connector.ID)
+	plan.Name = types.StringValue(connector.Name)
+	plan.Type = types.StringValue(connector.Type)
+	plan.Revision = types.Int64Value(connector.Revision)
+
+	tflog.Info(ctx, "Updated connector", map[string]any{"id": connector.ID, "kind": string(r.kind)})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource
+func (r *connectorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state connectorResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteConnector(ctx, r.kind, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Connector",
+			fmt.Sprintf("Could not delete %s connector %s: %s", r.kind, state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Deleted connector", map[string]any{"id": state.ID.ValueString(), "kind": string(r.kind)})
+}
+
+// ImportState imports the resource state
+func (r *connectorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ datasource.DataSource              = &currentIdentityDataSource{}
+	_ datasource.DataSourceWithConfigure = &currentIdentityDataSource{}
+)
+
+// NewCurrentIdentityDataSource creates a new current identity data source
+func NewCurrentIdentityDataSource() datasource.DataSource {
+	return &currentIdentityDataSource{}
+}
+
+// currentIdentityDataSource defines the data source implementation
+type currentIdentityDataSource struct {
+	client *client.Client
+}
+
+// currentIdentityDataSourceModel describes the data source data model
+type currentIdentityDataSourceModel struct {
+	OrganizationID   types.String `tfsdk:"organization_id"`
+	OrganizationName types.String `tfsdk:"organization_name"`
+	PrincipalType    types.String `tfsdk:"principal_type"`
+	PrincipalID      types.String `tfsdk:"principal_id"`
+	Email            types.String `tfsdk:"email"`
+	Scopes           types.Set    `tfsdk:"scopes"`
+}
+
+// Metadata returns the data source type name
+func (d *currentIdentityDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_current_identity"
+}
+
+// Schema defines the data source schema
+func (d *currentIdentityDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns the organization and principal the configured API token belongs to.",
+		Attributes: map[string]schema.Attribute{
+			"organization_id": schema.StringAttribute{
+				Description: "The unique identifier of the organization.",
+				Computed:    true,
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "The name of the organization.",
+				Computed:    true,
+			},
+			"principal_type": schema.StringAttribute{
+				Description: "The type of the principal: user or service_account.",
+				Computed:    true,
+			},
+			"principal_id": schema.StringAttribute{
+				Description: "The unique identifier of the user or service account.",
+				Computed:    true,
+			},
+			"email": schema.StringAttribute{
+				Description: "The email address of the user. Null for service accounts.",
+				Computed:    true,
+			},
+			"scopes": schema.SetAttribute{
+				Description: "The scopes granted to the API token.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source
+func (d *currentIdentityDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the data source state
+func (d *currentIdentityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	identity, err := d.client.GetCurrentIdentity(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Current Identity",
+			fmt.Sprintf("Could not read the identity of the API token: %s", err.Error()),
+		)
+		return
+	}
+
+	state := currentIdentityDataSourceModel{
+		OrganizationID:   types.StringValue(identity.OrganizationID),
+		OrganizationName: types.StringValue(identity.OrganizationName),
+		PrincipalType:    types.StringValue(identity.PrincipalType),
+		PrincipalID:      types.StringValue(identity.PrincipalID),
+		Email:            types.StringPointerValue(identity.Email),
+	}
+
+	scopes, diags := types.SetValueFrom(ctx, types.StringType, append([]string{}, identity.Scopes...))
+	resp.Diagnostics.Append(diags...)
+	state.Scopes = scopes
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ datasource.DataSource                     = &envDataSource{}
+	_ datasource.DataSourceWithConfigure        = &envDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &envDataSource{}
+)
+
+// NewEnvDataSource creates a new environment data source
+func NewEnvDataSource() datasource.DataSource {
+	return &envDataSource{}
+}
+
+// envDataSource defines the data source implementation
+type envDataSource struct {
+	client *client.Client
+}
+
+// envDataSourceModel describes the data source data model
+type envDataSourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	UseRetention           types.Bool   `tfsdk:"use_retention"`
+	RetentionConfiguration types.String `tfsdk:"retention_configuration"`
+}
+
+// Metadata returns the data source type name
+func (d *envDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_env"
+}
+
+// Schema defines the data source schema
+func (d *envDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a Popsink environment by ID or name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the environment. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the environment. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"use_retention": schema.BoolAttribute{
+				Description: "Whether message retention is enabled for this environment.",
+				Computed:    true,
+			},
+			"retention_configuration": schema.StringAttribute{
+				Description: "Normalized retention configuration as a JSON string, with secret values masked. Null when the environment has no retention configuration.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source
+func (d *envDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// ConfigValidators returns the data source-level configuration validators
+func (d *envDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
+}
+
+// Read refreshes the data source state
+func (d *envDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config envDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var env *client.EnvRead
+	if !config.ID.IsNull() {
+		var err error
+		env, err = d.client.GetEnv(ctx, config.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Environment",
+				fmt.Sprintf("Could not read environment %s: %s", config.ID.ValueString(), err.Error()),
+			)
+			return
+		}
+
+		if env == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Environment Not Found",
+				fmt.Sprintf("No environment has the ID %s.", config.ID.ValueString()),
+			)
+			return
+		}
+	} else {
+		name := config.Name.ValueString()
+		envs, err := d.client.ListEnvs(ctx, client.EnvFilter{Name: name})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Listing Environments",
+				fmt.Sprintf("Could not list environments: %s", err.Error()),
+			)
+			return
+		}
+
+		// The API filter may match partially, so only keep exact matches
+		var ids []string
+		for i := range envs {
+			if envs[i].Name == name {
+				env = &envs[i]
+				ids = append(ids, envs[i].ID)
+			}
+		}
+
+		switch len(ids) {
+		case 0:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Environment Not Found",
+				fmt.Sprintf("No environment is named %q.", name),
+			)
+			return
+		case 1:
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Multiple Environments Found",
+				fmt.Sprintf("%d environments are named %q: %s. Use id to select one.", len(ids), name, strings.Join(ids, ", ")),
+			)
+			return
+		}
+	}
+
+	config.ID = types.StringValue(env.ID)
+	config.Name = types.StringValue(env.Name)
+	config.UseRetention = types.BoolValue(env.UseRetention)
+	config.RetentionConfiguration = types.StringNull()
+
+	if env.RetentionConfiguration != nil {
+		retentionJSON, err := json.Marshal(maskSecrets(normalizeRetentionConfig(*env.RetentionConfiguration)))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Marshaling Retention Configuration",
+				fmt.Sprintf("Could not marshal retention configuration: %s", err.Error()),
+			)
+			return
+		}
+		config.RetentionConfiguration = types.StringValue(string(retentionJSON))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ list.ListResource              = &envListResource{}
+	_ list.ListResourceWithConfigure = &envListResource{}
+)
+
+// NewEnvListResource creates a new environment list resource
+func NewEnvListResource() list.ListResource {
+	return &envListResource{}
+}
+
+// envListResource defines the list resource implementation
+type envListResource struct {
+	client *client.Client
+}
+
+// envListResourceModel describes the list resource configuration model
+type envListResourceModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+}
+
+// Metadata returns the resource type name
+func (r *envListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_env"
+}
+
+// ListResourceConfigSchema defines the list resource configuration schema
+func (r *envListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the Popsink environments of the organization.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Description: "Only list environments whose name matches this regular expression.",
+				Optional:    true,
+				Validators: []validator.String{
+					regexpValidator{},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the list resource
+func (r *envListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// List streams the environments matching the configuration
+func (r *envListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config envListResourceModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	nameFilter, err := nameRegexp(config.NameRegex)
+	if err != nil {
+		diags.AddError(
+			"Invalid Regular Expression",
+			fmt.Sprintf("Could not compile name_regex: %s", err.Error()),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	envs, err := r.client.ListEnvs(ctx, client.EnvFilter{})
+	if err != nil {
+		diags.AddError(
+			"Error Listing Environments",
+			fmt.Sprintf("Could not list environments: %s", err.Error()),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for _, env := range envs {
+			if nameFilter != nil && !nameFilter.MatchString(env.Name) {
+				continue
+			}
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			count++
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = env.Name
+			result.Diagnostics.Append(setResourceIdentity(ctx, r.client, result.Identity, types.StringValue(env.ID))...)
+
+			if req.IncludeResource {
+				model := envResourceModel{
+					ID:                     types.StringValue(env.ID),
+					Name:                   types.StringValue(env.Name),
+					UseRetention:           types.BoolValue(env.UseRetention),
+					RetentionConfiguration: types.StringNull(),
+				}
+				result.Diagnostics.Append(model.setRetentionConfiguration(env.RetentionConfiguration)...)
+				result.Diagnostics.Append(result.Resource.Set(ctx, &model)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
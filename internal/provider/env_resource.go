@@ -4,14 +4,19 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/popsink/terraform-provider-popsink/internal/client"
 )
@@ -33,11 +38,135 @@ func normalizeRetentionConfig(config client.BrokerConfiguration) map[string]any
 	return normalized
 }
 
+// envRetentionConfigValidator validates that the retention configuration is consistent
+// with use_retention and that SASL credentials are set for SASL security protocols
+type envRetentionConfigValidator struct{}
+
+// Description returns a description of the validator
+func (v envRetentionConfigValidator) Description(_ context.Context) string {
+	return "validates that a retention configuration is set if and only if use_retention is true, " +
+		"and that SASL credentials are set when security_protocol is SASL_PLAINTEXT or SASL_SSL"
+}
+
+// MarkdownDescription returns a markdown description of the validator
+func (v envRetentionConfigValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateResource performs the validation
+func (v envRetentionConfigValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var useRetention types.Bool
+	var retentionConfiguration types.String
+	var retention types.Object
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("use_retention"), &useRetention)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("retention_configuration"), &retentionConfiguration)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("retention"), &retention)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasConfiguration := !retentionConfiguration.IsNull() || !retention.IsNull()
+
+	// use_retention defaults to false when it is not configured
+	if !useRetention.IsUnknown() {
+		if useRetention.ValueBool() && !hasConfiguration {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("use_retention"),
+				"Missing Retention Configuration",
+				"A retention block (or the deprecated retention_configuration attribute) is required when use_retention is true.",
+			)
+		}
+
+		if !useRetention.ValueBool() && hasConfiguration {
+			attributePath := path.Root("retention")
+			if !retentionConfiguration.IsNull() {
+				attributePath = path.Root("retention_configuration")
+			}
+
+			resp.Diagnostics.AddAttributeError(
+				attributePath,
+				"Unexpected Retention Configuration",
+				"A retention configuration can only be set when use_retention is true.",
+			)
+		}
+	}
+
+	if !retention.IsNull() && !retention.IsUnknown() {
+		var block envRetentionModel
+		resp.Diagnostics.Append(retention.As(ctx, &block, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if block.SecurityProtocol.IsUnknown() || !isSASLProtocol(block.SecurityProtocol.ValueString()) {
+			return
+		}
+
+		credentials := []struct {
+			name    string
+			missing bool
+		}{
+			{retentionKeySASLUsername, block.SASLUsername.IsNull()},
+			{retentionKeySASLPassword, block.SASLPassword.IsNull() && block.SASLPasswordSecretRef.IsNull()},
+		}
+		for _, credential := range credentials {
+			if credential.missing {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("retention").AtName(credential.name),
+					"Missing SASL Credentials",
+					fmt.Sprintf("%s is required when security_protocol is %s.", credential.name, block.SecurityProtocol.ValueString()),
+				)
+			}
+		}
+	}
+
+	if !retentionConfiguration.IsNull() && !retentionConfiguration.IsUnknown() {
+		var config client.BrokerConfiguration
+		if err := json.Unmarshal([]byte(retentionConfiguration.ValueString()), &config); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retention_configuration"),
+				"Invalid JSON",
+				fmt.Sprintf("Could not parse retention_configuration as JSON: %s", err.Error()),
+			)
+			return
+		}
+
+		protocol, _ := config[retentionKeySecurityProtocol].(string)
+		if !isSASLProtocol(protocol) {
+			return
+		}
+
+		for _, name := range []string{retentionKeySASLUsername, retentionKeySASLPassword} {
+			if _, ok := secretRef(config[name]); ok {
+				continue
+			}
+
+			if value, _ := config[name].(string); value == "" {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("retention_configuration"),
+					"Missing SASL Credentials",
+					fmt.Sprintf("%s is required when security_protocol is %s.", name, protocol),
+				)
+			}
+		}
+	}
+}
+
+// isSASLProtocol reports whether a security protocol authenticates with SASL
+func isSASLProtocol(protocol string) bool {
+	return strings.HasPrefix(protocol, "SASL_")
+}
+
 // Ensure the implementation satisfies the expected interfaces
 var (
-	_ resource.Resource                = &envResource{}
-	_ resource.ResourceWithConfigure   = &envResource{}
-	_ resource.ResourceWithImportState = &envResource{}
+	_ resource.Resource                     = &envResource{}
+	_ resource.ResourceWithConfigure        = &envResource{}
+	_ resource.ResourceWithConfigValidators = &envResource{}
+	_ resource.ResourceWithImportState      = &envResource{}
+	_ resource.ResourceWithIdentity         = &envResource{}
+	_ resource.ResourceWithUpgradeState     = &envResource{}
+	_ resource.ResourceWithMoveState        = &envResource{}
 )
 
 // NewEnvResource creates a new environment resource
@@ -52,10 +181,11 @@ type envResource struct {
 
 // envResourceModel describes the resource data model
 type envResourceModel struct {
-	ID                     types.String `tfsdk:"id"`
-	Name                   types.String `tfsdk:"name"`
-	UseRetention           types.Bool   `tfsdk:"use_retention"`
-	RetentionConfiguration types.String `tfsdk:"retention_configuration"`
+	ID                     types.String       `tfsdk:"id"`
+	Name                   types.String       `tfsdk:"name"`
+	UseRetention           types.Bool         `tfsdk:"use_retention"`
+	RetentionConfiguration types.String       `tfsdk:"retention_configuration"`
+	Retention              *envRetentionModel `tfsdk:"retention"`
 }
 
 // Metadata returns the resource type name
@@ -66,6 +196,7 @@ func (r *envResource) Metadata(ctx context.Context, req resource.MetadataRequest
 // Schema defines the resource schema
 func (r *envResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     1,
 		Description: "Manages a Popsink environment resource.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -86,13 +217,78 @@ func (r *envResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 				Default:     booldefault.StaticBool(false),
 			},
 			"retention_configuration": schema.StringAttribute{
-				Description: "Retention policy configuration as a JSON string. Only used when use_retention is true.",
-				Optional:    true,
+				Description: "Retention policy configuration as a JSON string. Only used when use_retention is true. " +
+					"Deprecated in favor of the retention block.",
+				Optional:           true,
+				DeprecationMessage: "Use the retention block instead. Moving the same settings into a retention block results in an in-place update.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("retention")),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"retention": schema.SingleNestedBlock{
+				Description: "Typed retention broker configuration. Only used when use_retention is true.",
+				Attributes: map[string]schema.Attribute{
+					"bootstrap_server": schema.StringAttribute{
+						Description: "Bootstrap server of the retention broker, e.g. kafka.example.com:9092.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"security_protocol": schema.StringAttribute{
+						Description: "Security protocol used to connect to the retention broker. Valid values: PLAINTEXT, SSL, SASL_PLAINTEXT, SASL_SSL.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(validSecurityProtocols...),
+						},
+					},
+					"sasl_mechanism": schema.StringAttribute{
+						Description: "SASL mechanism used to authenticate with the retention broker. Valid values: PLAIN, SCRAM-SHA-256, SCRAM-SHA-512.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(validSASLMechanisms...),
+						},
+					},
+					"sasl_username": schema.StringAttribute{
+						Description: "SASL username used to authenticate with the retention broker.",
+						Optional:    true,
+					},
+					"sasl_password": schema.StringAttribute{
+						Description: "SASL password used to authenticate with the retention broker.",
+						Optional:    true,
+						Sensitive:   true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("sasl_password_secret_ref")),
+						},
+					},
+					"sasl_password_secret_ref": schema.StringAttribute{
+						Description: "The UUID of a popsink_secret holding the SASL password, resolved by Popsink instead of storing the password in the environment configuration.",
+						Optional:    true,
+					},
+					"topic_prefix": schema.StringAttribute{
+						Description: "Prefix applied to the retention topics.",
+						Optional:    true,
+					},
+					"retention_ms": schema.Int64Attribute{
+						Description: "Retention time in milliseconds.",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+				},
 			},
 		},
 	}
 }
 
+// IdentitySchema defines the resource identity schema
+func (r *envResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema("environment")
+}
+
 // Configure adds the provider configured client to the resource
 func (r *envResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -111,6 +307,13 @@ func (r *envResource) Configure(ctx context.Context, req resource.ConfigureReque
 	r.client = client
 }
 
+// ConfigValidators returns the resource-level configuration validators
+func (r *envResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		envRetentionConfigValidator{},
+	}
+}
+
 // Create creates the resource
 func (r *envResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan envResourceModel
@@ -126,18 +329,12 @@ func (r *envResource) Create(ctx context.Context, req resource.CreateRequest, re
 	}
 
 	// Handle retention configuration if provided
-	if !plan.RetentionConfiguration.IsNull() && !plan.RetentionConfiguration.IsUnknown() && plan.RetentionConfiguration.ValueString() != "" {
-		var retentionConfig client.BrokerConfiguration
-		if err := json.Unmarshal([]byte(plan.RetentionConfiguration.ValueString()), &retentionConfig); err != nil {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("retention_configuration"),
-				"Invalid JSON",
-				fmt.Sprintf("Could not parse retention_configuration as JSON: %s", err.Error()),
-			)
-			return
-		}
-		createReq.RetentionConfiguration = &retentionConfig
+	retentionConfig, diags := plan.retentionConfiguration()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	createReq.RetentionConfiguration = retentionConfig
 
 	env, err := r.client.CreateEnv(ctx, createReq)
 	if err != nil {
@@ -153,25 +350,15 @@ func (r *envResource) Create(ctx context.Context, req resource.CreateRequest, re
 	plan.Name = types.StringValue(env.Name)
 	plan.UseRetention = types.BoolValue(env.UseRetention)
 
-	if env.RetentionConfiguration != nil {
-		// Normalize the retention configuration to match the planned configuration
-		normalizedConfig := normalizeRetentionConfig(*env.RetentionConfiguration)
-		retentionJSON, err := json.Marshal(normalizedConfig)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Marshaling Retention Configuration",
-				fmt.Sprintf("Could not marshal retention configuration: %s", err.Error()),
-			)
-			return
-		}
-		plan.RetentionConfiguration = types.StringValue(string(retentionJSON))
-	} else {
-		plan.RetentionConfiguration = types.StringNull()
+	resp.Diagnostics.Append(plan.setRetentionConfiguration(env.RetentionConfiguration)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	tflog.Info(ctx, "Created environment", map[string]any{"id": env.ID})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, r.client, resp.Identity, plan.ID)...)
 }
 
 // Read refreshes the resource state
@@ -201,23 +388,13 @@ func (r *envResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	state.Name = types.StringValue(env.Name)
 	state.UseRetention = types.BoolValue(env.UseRetention)
 
-	if env.RetentionConfiguration != nil {
-		// Normalize the retention configuration to match the planned configuration
-		normalizedConfig := normalizeRetentionConfig(*env.RetentionConfiguration)
-		retentionJSON, err := json.Marshal(normalizedConfig)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Marshaling Retention Configuration",
-				fmt.Sprintf("Could not marshal retention configuration: %s", err.Error()),
-			)
-			return
-		}
-		state.RetentionConfiguration = types.StringValue(string(retentionJSON))
-	} else {
-		state.RetentionConfiguration = types.StringNull()
+	resp.Diagnostics.Append(state.setRetentionConfiguration(env.RetentionConfiguration)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, r.client, resp.Identity, state.ID)...)
 }
 
 // Update updates the resource
@@ -247,22 +424,14 @@ func (r *envResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		updateReq.UseRetention = &useRetention
 	}
 
-	if !plan.RetentionConfiguration.Equal(state.RetentionConfiguration) {
-		if !plan.RetentionConfiguration.IsNull() && !plan.RetentionConfiguration.IsUnknown() && plan.RetentionConfiguration.ValueString() != "" {
-			var retentionConfig client.BrokerConfiguration
-			if err := json.Unmarshal([]byte(plan.RetentionConfiguration.ValueString()), &retentionConfig); err != nil {
-				resp.Diagnostics.AddAttributeError(
-					path.Root("retention_configuration"),
-					"Invalid JSON",
-					fmt.Sprintf("Could not parse retention_configuration as JSON: %s", err.Error()),
-				)
-				return
-			}
-			updateReq.RetentionConfiguration = &retentionConfig
-		} else {
-			// Set to nil to remove the retention configuration
-			updateReq.RetentionConfiguration = nil
+	if !plan.RetentionConfiguration.Equal(state.RetentionConfiguration) || retentionBlockChanged(plan.Retention, state.Retention) {
+		// A nil configuration removes the retention configuration
+		retentionConfig, diags := plan.retentionConfiguration()
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
+		updateReq.RetentionConfiguration = retentionConfig
 	}
 
 	// Update environment
@@ -298,25 +467,15 @@ func (r *envResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	plan.Name = types.StringValue(env.Name)
 	plan.UseRetention = types.BoolValue(env.UseRetention)
 
-	if env.RetentionConfiguration != nil {
-		// Normalize the retention configuration to match the planned configuration
-		normalizedConfig := normalizeRetentionConfig(*env.RetentionConfiguration)
-		retentionJSON, err := json.Marshal(normalizedConfig)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Marshaling Retention Configuration",
-				fmt.Sprintf("Could not marshal retention configuration: %s", err.Error()),
-			)
-			return
-		}
-		plan.RetentionConfiguration = types.StringValue(string(retentionJSON))
-	} else {
-		plan.RetentionConfiguration = types.StringNull()
+	resp.Diagnostics.Append(plan.setRetentionConfiguration(env.RetentionConfiguration)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	tflog.Info(ctx, "Updated environment", map[string]any{"id": env.ID})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, r.client, resp.Identity, plan.ID)...)
 }
 
 // Delete deletes the resource
@@ -339,7 +498,45 @@ func (r *envResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 	tflog.Info(ctx, "Deleted environment", map[string]any{"id": state.ID.ValueString()})
 }
 
-// ImportState imports the resource state
+// ImportState imports the resource state from an identity or an ID of the form env_id or env_name
 func (r *envResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if req.ID == "" {
+		importStateFromIdentity(ctx, r.client, req, resp)
+		return
+	}
+
+	id, err := resolveEnvID(ctx, r.client, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Environment",
+			fmt.Sprintf("Could not resolve import ID %q: %s", req.ID, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// UpgradeState upgrades states written with prior schema versions
+func (r *envResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   envSchemaV0(),
+			StateUpgrader: upgradeStateV0(envModelFromV0),
+		},
+	}
+}
+
+// MoveState moves the state of the same resource type from another provider, such as a fork of this provider
+func (r *envResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			SourceSchema: envSchemaV0(),
+			StateMover:   moveStateV0("popsink_env", envModelFromV0),
+		},
+		{
+			SourceSchema: currentSchema(ctx, r),
+			StateMover:   moveStateAsIs[envResourceModel]("popsink_env", 1),
+		},
+	}
 }
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// envConfig builds an environment configuration from the given attribute values, leaving the rest null
+func envConfig(t *testing.T, values map[string]tftypes.Value) tfsdk.Config {
+	t.Helper()
+
+	return resourceConfig(t, NewEnvResource(), values)
+}
+
+// retentionBlock builds a retention block value from the given attribute values, leaving the rest null
+func retentionBlock(values map[string]tftypes.Value) tftypes.Value {
+	attributeTypes := map[string]tftypes.Type{
+		"bootstrap_server":         tftypes.String,
+		"security_protocol":        tftypes.String,
+		"sasl_mechanism":           tftypes.String,
+		"sasl_username":            tftypes.String,
+		"sasl_password":            tftypes.String,
+		"topic_prefix":             tftypes.String,
+		"retention_ms":             tftypes.Number,
+		"sasl_password_secret_ref": tftypes.String,
+	}
+
+	attributes := make(map[string]tftypes.Value, len(attributeTypes))
+	for name, attributeType := range attributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range values {
+		attributes[name] = value
+	}
+
+	return tftypes.NewValue(tftypes.Object{AttributeTypes: attributeTypes}, attributes)
+}
+
+func TestEnvRetentionConfigValidator(t *testing.T) {
+	tests := []struct {
+		name      string
+		values    map[string]tftypes.Value
+		wantError bool
+	}{
+		{
+			name:      "no retention",
+			values:    map[string]tftypes.Value{},
+			wantError: false,
+		},
+		{
+			name: "use_retention without configuration",
+			values: map[string]tftypes.Value{
+				"use_retention": tftypes.NewValue(tftypes.Bool, true),
+			},
+			wantError: true,
+		},
+		{
+			name: "configuration without use_retention",
+			values: map[string]tftypes.Value{
+				"retention_configuration": tftypes.NewValue(tftypes.String, `{"bootstrap_server":"kafka:9092"}`),
+			},
+			wantError: true,
+		},
+		{
+			name: "block without use_retention",
+			values: map[string]tftypes.Value{
+				"use_retention": tftypes.NewValue(tftypes.Bool, false),
+				"retention": retentionBlock(map[string]tftypes.Value{
+					"bootstrap_server": tftypes.NewValue(tftypes.String, "kafka:9092"),
+				}),
+			},
+			wantError: true,
+		},
+		{
+			name: "block with use_retention",
+			values: map[string]tftypes.Value{
+				"use_retention": tftypes.NewValue(tftypes.Bool, true),
+				"retention": retentionBlock(map[string]tftypes.Value{
+					"bootstrap_server": tftypes.NewValue(tftypes.String, "kafka:9092"),
+				}),
+			},
+			wantError: false,
+		},
+		{
+			name: "unknown use_retention",
+			values: map[string]tftypes.Value{
+				"use_retention": tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+			},
+			wantError: false,
+		},
+		{
+			name: "block with SASL protocol and credentials",
+			values: map[string]tftypes.Value{
+				"use_retention": tftypes.NewValue(tftypes.Bool, true),
+				"retention": retentionBlock(map[string]tftypes.Value{
+					"security_protocol": tftypes.NewValue(tftypes.String, "SASL_SSL"),
+					"sasl_username":     tftypes.NewValue(tftypes.String, "user"),
+					"sasl_password":     tftypes.NewValue(tftypes.String, "password"),
+				}),
+			},
+			wantError: false,
+		},
+		{
+			name: "block with SASL protocol and missing password",
+			values: map[string]tftypes.Value{
+				"use_retention": tftypes.NewValue(tftypes.Bool, true),
+				"retention": retentionBlock(map[string]tftypes.Value{
+					"security_protocol": tftypes.NewValue(tftypes.String, "SASL_PLAINTEXT"),
+					"sasl_username":     tftypes.NewValue(tftypes.String, "user"),
+				}),
+			},
+			wantError: true,
+		},
+		{
+			name: "block with SASL protocol and password secret",
+			values: map[string]tftypes.Value{
+				"use_retention": tftypes.NewValue(tftypes.Bool, true),
+				"retention": retentionBlock(map[string]tftypes.Value{
+					"security_protocol":        tftypes.NewValue(tftypes.String, "SASL_SSL"),
+					"sasl_username":            tftypes.NewValue(tftypes.String, "user"),
+					"sasl_password_secret_ref": tftypes.NewValue(tftypes.String, "secret-123"),
+				}),
+			},
+			wantError: false,
+		},
+		{
+			name: "JSON with SASL protocol and password secret",
+			values: map[string]tftypes.Value{
+				"use_retention":           tftypes.NewValue(tftypes.Bool, true),
+				"retention_configuration": tftypes.NewValue(tftypes.String, `{"security_protocol":"SASL_SSL","sasl_username":"user","sasl_password":{"secret_ref":"secret-123"}}`),
+			},
+			wantError: false,
+		},
+		{
+			name: "JSON with SASL protocol and missing credentials",
+			values: map[string]tftypes.Value{
+				"use_retention":           tftypes.NewValue(tftypes.Bool, true),
+				"retention_configuration": tftypes.NewValue(tftypes.String, `{"security_protocol":"SASL_SSL"}`),
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := resource.ValidateConfigRequest{Config: envConfig(t, tt.values)}
+			resp := &resource.ValidateConfigResponse{}
+
+			envRetentionConfigValidator{}.ValidateResource(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("ValidateResource() diagnostics = %v, wantError %v", resp.Diagnostics, tt.wantError)
+			}
+		})
+	}
+}
@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+// Retention configuration keys understood by the typed retention block
+const (
+	retentionKeyBootstrapServer  = "bootstrap_server"
+	retentionKeySecurityProtocol = "security_protocol"
+	retentionKeySASLMechanism    = "sasl_mechanism"
+	retentionKeySASLUsername     = "sasl_username"
+	retentionKeySASLPassword     = "sasl_password"
+	retentionKeyTopicPrefix      = "topic_prefix"
+	retentionKeyRetentionMs      = "retention_ms"
+)
+
+// Valid retention broker security protocols
+var validSecurityProtocols = []string{
+	"PLAINTEXT",
+	"SSL",
+	"SASL_PLAINTEXT",
+	"SASL_SSL",
+}
+
+// Valid retention broker SASL mechanisms
+var validSASLMechanisms = []string{
+	"PLAIN",
+	"SCRAM-SHA-256",
+	"SCRAM-SHA-512",
+}
+
+// envRetentionModel describes the typed retention block data model
+type envRetentionModel struct {
+	BootstrapServer       types.String `tfsdk:"bootstrap_server"`
+	SecurityProtocol      types.String `tfsdk:"security_protocol"`
+	SASLMechanism         types.String `tfsdk:"sasl_mechanism"`
+	SASLUsername          types.String `tfsdk:"sasl_username"`
+	SASLPassword          types.String `tfsdk:"sasl_password"`
+	TopicPrefix           types.String `tfsdk:"topic_prefix"`
+	RetentionMs           types.Int64  `tfsdk:"retention_ms"`
+	SASLPasswordSecretRef types.String `tfsdk:"sasl_password_secret_ref"`
+}
+
+// newEnvRetentionModel builds the typed retention block from a normalized API configuration.
+// The SASL password is kept from the prior block when the API does not return it, unless
+// it references a secret.
+func newEnvRetentionModel(config map[string]any, prior *envRetentionModel) *envRetentionModel {
+	stringValue := func(key string) types.String {
+		if value, ok := config[key].(string); ok {
+			return types.StringValue(value)
+		}
+		return types.StringNull()
+	}
+
+	retention := &envRetentionModel{
+		BootstrapServer:       stringValue(retentionKeyBootstrapServer),
+		SecurityProtocol:      stringValue(retentionKeySecurityProtocol),
+		SASLMechanism:         stringValue(retentionKeySASLMechanism),
+		SASLUsername:          stringValue(retentionKeySASLUsername),
+		SASLPassword:          stringValue(retentionKeySASLPassword),
+		TopicPrefix:           stringValue(retentionKeyTopicPrefix),
+		RetentionMs:           types.Int64Null(),
+		SASLPasswordSecretRef: types.StringNull(),
+	}
+
+	if retentionMs, ok := int64FromAny(config[retentionKeyRetentionMs]); ok {
+		retention.RetentionMs = types.Int64Value(retentionMs)
+	}
+
+	if secretID, ok := secretRef(config[retentionKeySASLPassword]); ok {
+		retention.SASLPasswordSecretRef = types.StringValue(secretID)
+		return retention
+	}
+
+	if retention.SASLPassword.IsNull() && prior != nil {
+		retention.SASLPassword = prior.SASLPassword
+	}
+
+	return retention
+}
+
+// brokerConfiguration converts the typed retention block into the API representation
+func (m *envRetentionModel) brokerConfiguration() client.BrokerConfiguration {
+	config := client.BrokerConfiguration{}
+
+	stringValues := map[string]types.String{
+		retentionKeyBootstrapServer:  m.BootstrapServer,
+		retentionKeySecurityProtocol: m.SecurityProtocol,
+		retentionKeySASLMechanism:    m.SASLMechanism,
+		retentionKeySASLUsername:     m.SASLUsername,
+		retentionKeySASLPassword:     m.SASLPassword,
+		retentionKeyTopicPrefix:      m.TopicPrefix,
+	}
+	for key, value := range stringValues {
+		if !value.IsNull() && !value.IsUnknown() {
+			config[key] = value.ValueString()
+		}
+	}
+
+	if !m.RetentionMs.IsNull() && !m.RetentionMs.IsUnknown() {
+		config[retentionKeyRetentionMs] = m.RetentionMs.ValueInt64()
+	}
+
+	if !m.SASLPasswordSecretRef.IsNull() && !m.SASLPasswordSecretRef.IsUnknown() {
+		config[retentionKeySASLPassword] = secretRefValue(m.SASLPasswordSecretRef.ValueString())
+	}
+
+	return config
+}
+
+// retentionConfiguration builds the API retention configuration from either the
+// typed retention block or the legacy retention_configuration JSON string
+func (m *envResourceModel) retentionConfiguration() (*client.BrokerConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if m.Retention != nil {
+		config := m.Retention.brokerConfiguration()
+		return &config, diags
+	}
+
+	if m.RetentionConfiguration.IsNull() || m.RetentionConfiguration.IsUnknown() || m.RetentionConfiguration.ValueString() == "" {
+		return nil, diags
+	}
+
+	var config client.BrokerConfiguration
+	if err := json.Unmarshal([]byte(m.RetentionConfiguration.ValueString()), &config); err != nil {
+		diags.AddAttributeError(
+			path.Root("retention_configuration"),
+			"Invalid JSON",
+			fmt.Sprintf("Could not parse retention_configuration as JSON: %s", err.Error()),
+		)
+		return nil, diags
+	}
+
+	return &config, diags
+}
+
+// setRetentionConfiguration stores the API retention configuration in whichever
+// representation the model already uses. When neither is set (e.g. after import),
+// the typed block is preferred as long as every key can be represented by it.
+func (m *envResourceModel) setRetentionConfiguration(config *client.BrokerConfiguration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if config == nil {
+		m.Retention = nil
+		m.RetentionConfiguration = types.StringNull()
+		return diags
+	}
+
+	// Normalize the retention configuration to match the planned configuration
+	normalizedConfig := normalizeRetentionConfig(*config)
+
+	if m.Retention == nil && m.RetentionConfiguration.IsNull() {
+		if len(normalizedConfig) == 0 {
+			return diags
+		}
+
+		if isTypedRetentionConfig(normalizedConfig) {
+			m.Retention = newEnvRetentionModel(normalizedConfig, nil)
+			return diags
+		}
+	}
+
+	if m.Retention != nil {
+		m.Retention = newEnvRetentionModel(normalizedConfig, m.Retention)
+		m.RetentionConfiguration = types.StringNull()
+		return diags
+	}
+
+	retentionJSON, err := json.Marshal(normalizedConfig)
+	if err != nil {
+		diags.AddError(
+			"Error Marshaling Retention Configuration",
+			fmt.Sprintf("Could not marshal retention configuration: %s", err.Error()),
+		)
+		return diags
+	}
+	m.RetentionConfiguration = types.StringValue(string(retentionJSON))
+
+	return diags
+}
+
+// isTypedRetentionConfig reports whether every key of a normalized retention
+// configuration can be represented by the typed retention block
+func isTypedRetentionConfig(config map[string]any) bool {
+	for key, value := range config {
+		switch key {
+		case retentionKeySASLPassword:
+			if _, ok := secretRef(value); ok {
+				continue
+			}
+			if _, ok := value.(string); !ok {
+				return false
+			}
+		case retentionKeyBootstrapServer, retentionKeySecurityProtocol, retentionKeySASLMechanism,
+			retentionKeySASLUsername, retentionKeyTopicPrefix:
+			if _, ok := value.(string); !ok {
+				return false
+			}
+		case retentionKeyRetentionMs:
+			if _, ok := int64FromAny(value); !ok {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// retentionBlockChanged reports whether two typed retention blocks differ
+func retentionBlockChanged(a, b *envRetentionModel) bool {
+	if a == nil || b == nil {
+		return a != b
+	}
+	return *a != *b
+}
+
+// int64FromAny converts a JSON-decoded number into an int64
+func int64FromAny(value any) (int64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return int64(v), v == float64(int64(v))
+	case int64:
+		return v, true
+	case int:
+		return int64(v), true
+	case json.Number:
+		i, err := v.Int64()
+		return i, err == nil
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+func TestNewEnvRetentionModel(t *testing.T) {
+	tests := []struct {
+		name   string
+		config map[string]any
+		prior  *envRetentionModel
+		want   envRetentionModel
+	}{
+		{
+			name: "all keys",
+			config: map[string]any{
+				"bootstrap_server":  "kafka:9092",
+				"security_protocol": "SASL_SSL",
+				"sasl_mechanism":    "PLAIN",
+				"sasl_username":     "user",
+				"sasl_password":     "secret",
+				"topic_prefix":      "retention-",
+				"retention_ms":      float64(86400000),
+			},
+			want: envRetentionModel{
+				BootstrapServer:       types.StringValue("kafka:9092"),
+				SecurityProtocol:      types.StringValue("SASL_SSL"),
+				SASLMechanism:         types.StringValue("PLAIN"),
+				SASLUsername:          types.StringValue("user"),
+				SASLPassword:          types.StringValue("secret"),
+				TopicPrefix:           types.StringValue("retention-"),
+				RetentionMs:           types.Int64Value(86400000),
+				SASLPasswordSecretRef: types.StringNull(),
+			},
+		},
+		{
+			name: "password secret reference",
+			config: map[string]any{
+				"bootstrap_server": "kafka:9092",
+				"sasl_password":    map[string]any{"secret_ref": "secret-123"},
+				"retention_ms":     float64(1000),
+			},
+			prior: &envRetentionModel{SASLPassword: types.StringValue("stale")},
+			want: envRetentionModel{
+				BootstrapServer:       types.StringValue("kafka:9092"),
+				SecurityProtocol:      types.StringNull(),
+				SASLMechanism:         types.StringNull(),
+				SASLUsername:          types.StringNull(),
+				SASLPassword:          types.StringNull(),
+				TopicPrefix:           types.StringNull(),
+				RetentionMs:           types.Int64Value(1000),
+				SASLPasswordSecretRef: types.StringValue("secret-123"),
+			},
+		},
+		{
+			name:   "password kept from prior block",
+			config: map[string]any{"bootstrap_server": "kafka:9092"},
+			prior:  &envRetentionModel{SASLPassword: types.StringValue("secret")},
+			want: envRetentionModel{
+				BootstrapServer:       types.StringValue("kafka:9092"),
+				SecurityProtocol:      types.StringNull(),
+				SASLMechanism:         types.StringNull(),
+				SASLUsername:          types.StringNull(),
+				SASLPassword:          types.StringValue("secret"),
+				TopicPrefix:           types.StringNull(),
+				RetentionMs:           types.Int64Null(),
+				SASLPasswordSecretRef: types.StringNull(),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newEnvRetentionModel(tt.config, tt.prior)
+			if *got != tt.want {
+				t.Errorf("newEnvRetentionModel() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnvRetentionModel_BrokerConfiguration(t *testing.T) {
+	tests := []struct {
+		name      string
+		retention envRetentionModel
+		want      client.BrokerConfiguration
+	}{
+		{
+			name: "set values only",
+			retention: envRetentionModel{
+				BootstrapServer: types.StringValue("kafka:9092"),
+				RetentionMs:     types.Int64Value(1000),
+			},
+			want: client.BrokerConfiguration{
+				"bootstrap_server": "kafka:9092",
+				"retention_ms":     int64(1000),
+			},
+		},
+		{
+			name: "unknown values are skipped",
+			retention: envRetentionModel{
+				BootstrapServer: types.StringValue("kafka:9092"),
+				SASLPassword:    types.StringUnknown(),
+				RetentionMs:     types.Int64Unknown(),
+			},
+			want: client.BrokerConfiguration{
+				"bootstrap_server": "kafka:9092",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.retention.brokerConfiguration()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("brokerConfiguration() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnvResourceModel_SetRetentionConfiguration(t *testing.T) {
+	tests := []struct {
+		name                string
+		model               envResourceModel
+		config              *client.BrokerConfiguration
+		wantRetention       bool
+		wantRetentionConfig string
+	}{
+		{
+			name:          "typed configuration after import",
+			model:         envResourceModel{RetentionConfiguration: types.StringNull()},
+			config:        &client.BrokerConfiguration{"bootstrap_server": "kafka:9092", "retention_ms": float64(1000)},
+			wantRetention: true,
+		},
+		{
+			name:                "untyped configuration after import",
+			model:               envResourceModel{RetentionConfiguration: types.StringNull()},
+			config:              &client.BrokerConfiguration{"bootstrap_server": "kafka:9092", "linger_ms": float64(5)},
+			wantRetentionConfig: `{"bootstrap_server":"kafka:9092","linger_ms":5}`,
+		},
+		{
+			name:                "JSON representation is kept",
+			model:               envResourceModel{RetentionConfiguration: types.StringValue(`{"bootstrap_server":"old:9092"}`)},
+			config:              &client.BrokerConfiguration{"bootstrap_server": "kafka:9092"},
+			wantRetentionConfig: `{"bootstrap_server":"kafka:9092"}`,
+		},
+		{
+			name:          "typed representation is kept",
+			model:         envResourceModel{RetentionConfiguration: types.StringNull(), Retention: &envRetentionModel{}},
+			config:        &client.BrokerConfiguration{"bootstrap_server": "kafka:9092", "linger_ms": float64(5)},
+			wantRetention: true,
+		},
+		{
+			name:  "no configuration",
+			model: envResourceModel{RetentionConfiguration: types.StringValue(`{}`), Retention: &envRetentionModel{}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := tt.model
+			if diags := model.setRetentionConfiguration(tt.config); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if (model.Retention != nil) != tt.wantRetention {
+				t.Errorf("expected retention block %v, got %+v", tt.wantRetention, model.Retention)
+			}
+
+			if tt.wantRetentionConfig == "" {
+				if !model.RetentionConfiguration.IsNull() {
+					t.Errorf("expected null retention_configuration, got %s", model.RetentionConfiguration.ValueString())
+				}
+			} else if model.RetentionConfiguration.ValueString() != tt.wantRetentionConfig {
+				t.Errorf("expected retention_configuration %s, got %s", tt.wantRetentionConfig, model.RetentionConfiguration.ValueString())
+			}
+
+			if tt.wantRetention && model.Retention.BootstrapServer.ValueString() != "kafka:9092" {
+				t.Errorf("expected bootstrap_server kafka:9092, got %s", model.Retention.BootstrapServer.ValueString())
+			}
+		})
+	}
+}
+
+func TestInt64FromAny(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  any
+		want   int64
+		wantOK bool
+	}{
+		{name: "integral float", value: float64(86400000), want: 86400000, wantOK: true},
+		{name: "fractional float", value: 1.5, wantOK: false},
+		{name: "int64", value: int64(42), want: 42, wantOK: true},
+		{name: "int", value: 7, want: 7, wantOK: true},
+		{name: "json number", value: json.Number("1000"), want: 1000, wantOK: true},
+		{name: "invalid json number", value: json.Number("1e3.5"), wantOK: false},
+		{name: "string", value: "1000", wantOK: false},
+		{name: "nil", value: nil, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := int64FromAny(tt.value)
+			if ok != tt.wantOK || (ok && got != tt.want) {
+				t.Errorf("int64FromAny(%v) = (%d, %v), want (%d, %v)", tt.value, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
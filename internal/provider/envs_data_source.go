@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+// regexpValidator validates that a string is a valid regular expression
+type regexpValidator struct{}
+
+// Description returns a description of the validator
+func (v regexpValidator) Description(_ context.Context) string {
+	return "value must be a valid regular expression"
+}
+
+// MarkdownDescription returns a markdown description of the validator
+func (v regexpValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation
+func (v regexpValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid Regular Expression",
+			fmt.Sprintf("Could not compile %q: %s", request.ConfigValue.ValueString(), err.Error()),
+		)
+	}
+}
+
+// nameRegexp compiles the optional name_regex filter of a list data source.
+// A nil result matches every name.
+func nameRegexp(nameRegex types.String) (*regexp.Regexp, error) {
+	if nameRegex.IsNull() {
+		return nil, nil
+	}
+
+	return regexp.Compile(nameRegex.ValueString())
+}
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ datasource.DataSource              = &envsDataSource{}
+	_ datasource.DataSourceWithConfigure = &envsDataSource{}
+)
+
+// NewEnvsDataSource creates a new environments data source
+func NewEnvsDataSource() datasource.DataSource {
+	return &envsDataSource{}
+}
+
+// envsDataSource defines the data source implementation
+type envsDataSource struct {
+	client *client.Client
+}
+
+// envsDataSourceModel describes the data source data model
+type envsDataSourceModel struct {
+	NameRegex types.String       `tfsdk:"name_regex"`
+	Envs      []envsDataEnvModel `tfsdk:"envs"`
+}
+
+// envsDataEnvModel describes a single environment of the environments list
+type envsDataEnvModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	UseRetention types.Bool   `tfsdk:"use_retention"`
+}
+
+// Metadata returns the data source type name
+func (d *envsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_envs"
+}
+
+// Schema defines the data source schema
+func (d *envsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the Popsink environments of the organization.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Description: "Only return environments whose name matches this regular expression.",
+				Optional:    true,
+				Validators: []validator.String{
+					regexpValidator{},
+				},
+			},
+			"envs": schema.ListNestedAttribute{
+				Description: "The environments matching the filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the environment.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the environment.",
+							Computed:    true,
+						},
+						"use_retention": schema.BoolAttribute{
+							Description: "Whether message retention is enabled for the environment.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source
+func (d *envsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the data source state
+func (d *envsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config envsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameFilter, err := nameRegexp(config.NameRegex)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Regular Expression",
+			fmt.Sprintf("Could not compile name_regex: %s", err.Error()),
+		)
+		return
+	}
+
+	envs, err := d.client.ListEnvs(ctx, client.EnvFilter{})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Environments",
+			fmt.Sprintf("Could not list environments: %s", err.Error()),
+		)
+		return
+	}
+
+	config.Envs = make([]envsDataEnvModel, 0, len(envs))
+	for _, env := range envs {
+		if nameFilter != nil && !nameFilter.MatchString(env.Name) {
+			continue
+		}
+
+		config.Envs = append(config.Envs, envsDataEnvModel{
+			ID:           types.StringValue(env.ID),
+			Name:         types.StringValue(env.Name),
+			UseRetention: types.BoolValue(env.UseRetention),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
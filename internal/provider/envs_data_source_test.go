@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRegexpValidator(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     types.String
+		wantError bool
+	}{
+		{name: "valid", value: types.StringValue("^prod-.*$"), wantError: false},
+		{name: "invalid", value: types.StringValue("prod-("), wantError: true},
+		{name: "null", value: types.StringNull(), wantError: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("name_regex"), ConfigValue: tt.value}
+			resp := &validator.StringResponse{}
+
+			regexpValidator{}.ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("ValidateString() diagnostics = %v, wantError %v", resp.Diagnostics, tt.wantError)
+			}
+		})
+	}
+}
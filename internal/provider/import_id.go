@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+// uuidPattern matches the identifiers generated by the Popsink API
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// isUUID reports whether an import ID part is a UUID rather than a name
+func isUUID(s string) bool {
+	return uuidPattern.MatchString(s)
+}
+
+// splitImportID splits an import ID of the form parent/name, returning an empty parent when there is no separator
+func splitImportID(id string) (string, string) {
+	parent, name, found := strings.Cut(id, "/")
+	if !found {
+		return "", id
+	}
+	return parent, name
+}
+
+// uniqueID returns the only ID found by a name lookup, or an error listing the candidates when the lookup is ambiguous
+func uniqueID(object, description string, ids []string) (string, error) {
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no %s is %s", object, description)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("%d %ss are %s: %s; import by ID instead", len(ids), object, description, strings.Join(ids, ", "))
+	}
+}
+
+// resolveEnvID returns the ID of an environment given by ID or name
+func resolveEnvID(ctx context.Context, c *client.Client, env string) (string, error) {
+	if isUUID(env) {
+		return env, nil
+	}
+
+	envs, err := c.ListEnvs(ctx, client.EnvFilter{Name: env})
+	if err != nil {
+		return "", fmt.Errorf("could not list environments: %w", err)
+	}
+
+	// The API filter may match partially, so only keep exact matches
+	var ids []string
+	for _, e := range envs {
+		if e.Name == env {
+			ids = append(ids, e.ID)
+		}
+	}
+
+	return uniqueID("environment", fmt.Sprintf("named %q", env), ids)
+}
+
+// resolveTeamID returns the ID of a team given by ID, or by name within an optional environment given by ID or name
+func resolveTeamID(ctx context.Context, c *client.Client, env, team string) (string, error) {
+	if env == "" && isUUID(team) {
+		return team, nil
+	}
+
+	description := fmt.Sprintf("named %q", team)
+	var envID string
+	if env != "" {
+		var err error
+		envID, err = resolveEnvID(ctx, c, env)
+		if err != nil {
+			return "", err
+		}
+		description += fmt.Sprintf(" in environment %s", env)
+	}
+
+	teams, err := c.ListTeams(ctx, client.TeamFilter{Name: team, EnvID: envID})
+	if err != nil {
+		return "", fmt.Errorf("could not list teams: %w", err)
+	}
+
+	var ids []string
+	for _, t := range teams {
+		if t.Name != team || (envID != "" && (t.EnvID == nil || *t.EnvID != envID)) {
+			continue
+		}
+		ids = append(ids, t.ID)
+	}
+
+	return uniqueID("team", description, ids)
+}
+
+// resolvePipelineID returns the ID of a pipeline given by ID, or by name within a team given by ID or name
+func resolvePipelineID(ctx context.Context, c *client.Client, team, pipeline string) (string, error) {
+	if team == "" {
+		if isUUID(pipeline) {
+			return pipeline, nil
+		}
+		return "", fmt.Errorf("expected a pipeline ID or an ID of the form team_name/pipeline_name")
+	}
+
+	teamID, err := resolveTeamID(ctx, c, "", team)
+	if err != nil {
+		return "", err
+	}
+
+	pipelines, err := c.ListPipelines(ctx, client.PipelineFilter{TeamID: teamID, Name: pipeline})
+	if err != nil {
+		return "", fmt.Errorf("could not list pipelines: %w", err)
+	}
+
+	var ids []string
+	for _, p := range pipelines {
+		if p.Name == pipeline && p.TeamID == teamID {
+			ids = append(ids, p.ID)
+		}
+	}
+
+	return uniqueID("pipeline", fmt.Sprintf("named %q in team %s", pipeline, team), ids)
+}
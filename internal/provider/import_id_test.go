@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+func TestSplitImportID(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		wantParent string
+		wantName   string
+	}{
+		{name: "name only", id: "production", wantParent: "", wantName: "production"},
+		{name: "parent and name", id: "production/data", wantParent: "production", wantName: "data"},
+		{name: "uuid", id: "550e8400-e29b-41d4-a716-446655440000", wantParent: "", wantName: "550e8400-e29b-41d4-a716-446655440000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parent, name := splitImportID(tt.id)
+			if parent != tt.wantParent || name != tt.wantName {
+				t.Errorf("expected (%q, %q), got (%q, %q)", tt.wantParent, tt.wantName, parent, name)
+			}
+		})
+	}
+}
+
+// newImportTestClient returns a client for an API serving the given teams and pipelines
+func newImportTestClient(t *testing.T, teams []client.TeamRead, pipelines []client.PipelineRead) *client.Client {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		switch r.URL.Path {
+		case "/teams/":
+			_ = json.NewEncoder(w).Encode(client.Page[client.TeamRead]{Items: teams, Total: len(teams), Page: 1, Size: 100, Pages: 1})
+		case "/pipelines/":
+			_ = json.NewEncoder(w).Encode(client.Page[client.PipelineRead]{Items: pipelines, Total: len(pipelines), Page: 1, Size: 100, Pages: 1})
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return client.NewClient(server.URL, "test-token")
+}
+
+func TestResolvePipelineID(t *testing.T) {
+	envA, envB := "env-a", "env-b"
+
+	tests := []struct {
+		name      string
+		teams     []client.TeamRead
+		pipelines []client.PipelineRead
+		id        string
+		want      string
+		wantError string
+	}{
+		{
+			name: "uuid",
+			id:   "550e8400-e29b-41d4-a716-446655440000",
+			want: "550e8400-e29b-41d4-a716-446655440000",
+		},
+		{
+			name:      "name without team",
+			id:        "orders-sync",
+			wantError: "expected a pipeline ID",
+		},
+		{
+			name:  "team and pipeline names",
+			teams: []client.TeamRead{{ID: "team-1", Name: "data", EnvID: &envA}},
+			pipelines: []client.PipelineRead{
+				{ID: "pipeline-1", Name: "orders-sync", TeamID: "team-1"},
+				{ID: "pipeline-2", Name: "orders-sync-v2", TeamID: "team-1"},
+			},
+			id:   "data/orders-sync",
+			want: "pipeline-1",
+		},
+		{
+			name: "ambiguous team",
+			teams: []client.TeamRead{
+				{ID: "team-1", Name: "data", EnvID: &envA},
+				{ID: "team-2", Name: "data", EnvID: &envB},
+			},
+			id:        "data/orders-sync",
+			wantError: "2 teams are named \"data\": team-1, team-2",
+		},
+		{
+			name:      "pipeline not found",
+			teams:     []client.TeamRead{{ID: "team-1", Name: "data", EnvID: &envA}},
+			id:        "data/orders-sync",
+			wantError: "no pipeline is named \"orders-sync\" in team data",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newImportTestClient(t, tt.teams, tt.pipelines)
+			team, pipeline := splitImportID(tt.id)
+
+			got, err := resolvePipelineID(context.Background(), c, team, pipeline)
+			if tt.wantError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
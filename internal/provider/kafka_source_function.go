@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// connectorDefinition is the JSON representation of a connector returned by the
+// connector functions and consumed by pipeline_configuration
+type connectorDefinition struct {
+	Name   string         `json:"name"`
+	Type   string         `json:"type"`
+	Config map[string]any `json:"config"`
+}
+
+// Ensure the implementation satisfies the expected interfaces
+var _ function.Function = &kafkaSourceFunction{}
+
+// NewKafkaSourceFunction creates a new kafka_source function
+func NewKafkaSourceFunction() function.Function {
+	return &kafkaSourceFunction{}
+}
+
+// kafkaSourceFunction defines the function implementation
+type kafkaSourceFunction struct{}
+
+// Metadata returns the function name
+func (f *kafkaSourceFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "kafka_source"
+}
+
+// Definition defines the function parameters and return type
+func (f *kafkaSourceFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds a Kafka source connector",
+		Description: "Returns the JSON definition of a KAFKA_SOURCE connector, " +
+			"to be passed as the source argument of pipeline_configuration.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: "The name of the source.",
+			},
+			function.StringParameter{
+				Name:        "bootstrap_servers",
+				Description: "Comma-separated list of host:port pairs of the Kafka brokers.",
+			},
+			function.StringParameter{
+				Name:        "topic",
+				Description: "The topic consumed by the pipeline.",
+			},
+			function.StringParameter{
+				Name:        "consumer_group",
+				Description: "The consumer group used by the pipeline.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run builds the connector definition
+func (f *kafkaSourceFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name, bootstrapServers, topic, consumerGroup string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &name, &bootstrapServers, &topic, &consumerGroup))
+	if resp.Error != nil {
+		return
+	}
+
+	if name == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, "name must not be empty"))
+	}
+	for _, server := range strings.Split(bootstrapServers, ",") {
+		host, port, ok := strings.Cut(strings.TrimSpace(server), ":")
+		if !ok || host == "" || port == "" {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1,
+				fmt.Sprintf("bootstrap_servers must be a comma-separated list of host:port pairs, got %q", bootstrapServers)))
+			break
+		}
+	}
+	if topic == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, "topic must not be empty"))
+	}
+	if consumerGroup == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(3, "consumer_group must not be empty"))
+	}
+	if resp.Error != nil {
+		return
+	}
+
+	connector, err := json.Marshal(connectorDefinition{
+		Name: name,
+		Type: "KAFKA_SOURCE",
+		Config: map[string]any{
+			"bootstrap_servers": bootstrapServers,
+			"topic":             topic,
+			"consumer_group":    consumerGroup,
+		},
+	})
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Could not marshal connector: %s", err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, string(connector)))
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// normalizeConfigValue applies normalizeRetentionConfig to every object of a
+// decoded JSON value, so that null and empty string values are dropped at any depth
+func normalizeConfigValue(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		normalized := normalizeRetentionConfig(value)
+		for key, nested := range normalized {
+			normalized[key] = normalizeConfigValue(nested)
+		}
+		return normalized
+	case []any:
+		normalized := make([]any, len(value))
+		for i, nested := range value {
+			normalized[i] = normalizeConfigValue(nested)
+		}
+		return normalized
+	default:
+		return value
+	}
+}
+
+// parseNormalizedConfig decodes a JSON configuration and normalizes it
+func parseNormalizedConfig(config string) (any, error) {
+	var value any
+	if err := json.Unmarshal([]byte(config), &value); err != nil {
+		return nil, err
+	}
+
+	return normalizeConfigValue(value), nil
+}
+
+// Ensure the implementation satisfies the expected interfaces
+var _ function.Function = &normalizeConfigFunction{}
+
+// NewNormalizeConfigFunction creates a new normalize_config function
+func NewNormalizeConfigFunction() function.Function {
+	return &normalizeConfigFunction{}
+}
+
+// normalizeConfigFunction defines the function implementation
+type normalizeConfigFunction struct{}
+
+// Metadata returns the function name
+func (f *normalizeConfigFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_config"
+}
+
+// Definition defines the function parameters and return type
+func (f *normalizeConfigFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Normalizes a Popsink JSON configuration",
+		Description: "Returns the canonical form of a JSON configuration: null and empty string values are dropped " +
+			"at any depth, as the resources do with the configurations returned by the API, and object keys are sorted.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "config",
+				Description: "The JSON configuration to normalize.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run normalizes the configuration
+func (f *normalizeConfigFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var config string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &config))
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := parseNormalizedConfig(config)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Could not parse config as JSON: %s", err.Error()))
+		return
+	}
+
+	normalizedJSON, err := json.Marshal(normalized)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Could not marshal config: %s", err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, string(normalizedJSON)))
+}
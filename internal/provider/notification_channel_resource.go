@@ -0,0 +1,392 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+// Notification channel types and the arguments they use
+var notificationChannelSpecs = map[string]argumentSpec{
+	"webhook":   {required: []string{"url"}, optional: []string{"headers"}},
+	"email":     {required: []string{"emails"}},
+	"slack":     {required: []string{"url"}},
+	"pagerduty": {required: []string{"routing_key"}, optional: []string{"url"}},
+}
+
+// Type-specific notification channel arguments
+var notificationChannelArguments = []string{
+	"url",
+	"emails",
+	"routing_key",
+	"headers",
+}
+
+// Valid notification channel types
+var validNotificationChannelTypes = func() []string {
+	names := make([]string, 0, len(notificationChannelSpecs))
+	for channelType := range notificationChannelSpecs {
+		names = append(names, channelType)
+	}
+	sort.Strings(names)
+	return names
+}()
+
+// notificationChannelConfigValidator validates that a notification channel only
+// sets, and sets all, the arguments used by its type
+type notificationChannelConfigValidator struct{}
+
+// Description returns a description of the validator
+func (v notificationChannelConfigValidator) Description(_ context.Context) string {
+	return "validates that the notification channel sets the arguments required by its type and no other"
+}
+
+// MarkdownDescription returns a markdown description of the validator
+func (v notificationChannelConfigValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateResource performs the validation
+func (v notificationChannelConfigValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var channelType, url, routingKey types.String
+	var emails types.Set
+	var headers types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &channelType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("url"), &url)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("emails"), &emails)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("routing_key"), &routingKey)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("headers"), &headers)...)
+	if resp.Diagnostics.HasError() || channelType.IsUnknown() {
+		return
+	}
+
+	// Invalid types are reported by the type attribute validators
+	spec, ok := notificationChannelSpecs[channelType.ValueString()]
+	if !ok {
+		return
+	}
+
+	arguments := map[string]attr.Value{
+		"url":         url,
+		"emails":      emails,
+		"routing_key": routingKey,
+		"headers":     headers,
+	}
+	subject := channelType.ValueString() + " channels"
+	resp.Diagnostics.Append(spec.validate(path.Empty(), "Notification Channel", subject, notificationChannelArguments, arguments)...)
+}
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ resource.Resource                     = &notificationChannelResource{}
+	_ resource.ResourceWithConfigure        = &notificationChannelResource{}
+	_ resource.ResourceWithConfigValidators = &notificationChannelResource{}
+	_ resource.ResourceWithImportState      = &notificationChannelResource{}
+)
+
+// NewNotificationChannelResource creates a new notification channel resource
+func NewNotificationChannelResource() resource.Resource {
+	return &notificationChannelResource{}
+}
+
+// notificationChannelResource defines the resource implementation
+type notificationChannelResource struct {
+	client *client.Client
+}
+
+// notificationChannelResourceModel describes the resource data model
+type notificationChannelResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Type       types.String `tfsdk:"type"`
+	URL        types.String `tfsdk:"url"`
+	Emails     types.Set    `tfsdk:"emails"`
+	RoutingKey types.String `tfsdk:"routing_key"`
+	Headers    types.Map    `tfsdk:"headers"`
+}
+
+// setNotificationChannel copies the notification channel returned by the API into the model.
+// The routing key and headers are kept from the configuration since they are never returned.
+func (m *notificationChannelResourceModel) setNotificationChannel(ctx context.Context, channel *client.NotificationChannelRead) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(channel.ID)
+	m.Name = types.StringValue(channel.Name)
+	m.Type = types.StringValue(channel.Type)
+	m.URL = types.StringPointerValue(channel.URL)
+
+	m.Emails = types.SetNull(types.StringType)
+	if len(channel.Emails) > 0 {
+		emails, setDiags := types.SetValueFrom(ctx, types.StringType, channel.Emails)
+		diags.Append(setDiags...)
+		m.Emails = emails
+	}
+
+	return diags
+}
+
+// Metadata returns the resource type name
+func (r *notificationChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_channel"
+}
+
+// Schema defines the resource schema
+func (r *notificationChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Popsink notification channel, a destination for the notifications of alert rules.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the notification channel.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the notification channel.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: fmt.Sprintf("The type of the notification channel. Valid values: %v.", validNotificationChannelTypes),
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(validNotificationChannelTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: "The URL notifications are posted to. Required by webhook and slack channels, " +
+					"optional for pagerduty channels to override the events API endpoint.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^https?://`), "must be an http or https URL"),
+				},
+			},
+			"emails": schema.SetAttribute{
+				Description: "The email addresses notifications are sent to. Required by email channels.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(regexp.MustCompile(`^[^@\s]+@[^@\s]+$`), "must be an email address"),
+					),
+				},
+			},
+			"routing_key": schema.StringAttribute{
+				Description: "The integration routing key of the PagerDuty service. Required by pagerduty channels.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"headers": schema.MapAttribute{
+				Description: "HTTP headers sent with every notification, for example to authenticate with the receiver. Only used by webhook channels.",
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource
+func (r *notificationChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ConfigValidators returns the resource-level configuration validators
+func (r *notificationChannelResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		notificationChannelConfigValidator{},
+	}
+}
+
+// Create creates the resource
+func (r *notificationChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan notificationChannelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create notification channel
+	createReq := &client.NotificationChannelCreate{
+		Name:       plan.Name.ValueString(),
+		Type:       plan.Type.ValueString(),
+		URL:        plan.URL.ValueStringPointer(),
+		RoutingKey: plan.RoutingKey.ValueStringPointer(),
+	}
+	resp.Diagnostics.Append(plan.Emails.ElementsAs(ctx, &createReq.Emails, false)...)
+	resp.Diagnostics.Append(plan.Headers.ElementsAs(ctx, &createReq.Headers, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, err := r.client.CreateNotificationChannel(ctx, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Notification Channel",
+			fmt.Sprintf("Could not create notification channel: %s", err.Error()),
+		)
+		return
+	}
+
+	// Update state with created notification channel
+	resp.Diagnostics.Append(plan.setNotificationChannel(ctx, channel)...)
+
+	tflog.Info(ctx, "Created notification channel", map[string]any{"id": channel.ID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the resource state
+func (r *notificationChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state notificationChannelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, err := r.client.GetNotificationChannel(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Notification Channel",
+			fmt.Sprintf("Could not read notification channel %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// If notification channel not found, remove from state
+	if channel == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Update state
+	resp.Diagnostics.Append(state.setNotificationChannel(ctx, channel)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource
+func (r *notificationChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan notificationChannelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state notificationChannelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build update request
+	updateReq := &client.NotificationChannelUpdate{}
+
+	if !plan.Name.Equal(state.Name) {
+		name := plan.Name.ValueString()
+		updateReq.Name = &name
+	}
+
+	if !plan.URL.Equal(state.URL) {
+		url := plan.URL.ValueString()
+		updateReq.URL = &url
+	}
+
+	if !plan.Emails.Equal(state.Emails) {
+		emails := []string{}
+		resp.Diagnostics.Append(plan.Emails.ElementsAs(ctx, &emails, false)...)
+		updateReq.Emails = &emails
+	}
+
+	if !plan.RoutingKey.Equal(state.RoutingKey) {
+		routingKey := plan.RoutingKey.ValueString()
+		updateReq.RoutingKey = &routingKey
+	}
+
+	if !plan.Headers.Equal(state.Headers) {
+		headers := map[string]string{}
+		resp.Diagnostics.Append(plan.Headers.ElementsAs(ctx, &headers, false)...)
+		updateReq.Headers = &headers
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update notification channel
+	channel, err := r.client.UpdateNotificationChannel(ctx, state.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Notification Channel",
+			fmt.Sprintf("Could not update notification channel %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// Update state
+	resp.Diagnostics.Append(plan.setNotificationChannel(ctx, channel)...)
+
+	tflog.Info(ctx, "Updated notification channel", map[string]any{"id": channel.ID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource
+func (r *notificationChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state notificationChannelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteNotificationChannel(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Notification Channel",
+			fmt.Sprintf("Could not delete notification channel %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Deleted notification channel", map[string]any{"id": state.ID.ValueString()})
+}
+
+// ImportState imports the resource state
+func (r *notificationChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNotificationChannelConfigValidator(t *testing.T) {
+	emails := tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "oncall@example.com"),
+	})
+
+	tests := []struct {
+		name      string
+		values    map[string]tftypes.Value
+		wantError bool
+	}{
+		{
+			name: "valid webhook",
+			values: map[string]tftypes.Value{
+				"type": tftypes.NewValue(tftypes.String, "webhook"),
+				"url":  tftypes.NewValue(tftypes.String, "https://hooks.example.com/popsink"),
+			},
+			wantError: false,
+		},
+		{
+			name: "valid email",
+			values: map[string]tftypes.Value{
+				"type":   tftypes.NewValue(tftypes.String, "email"),
+				"emails": emails,
+			},
+			wantError: false,
+		},
+		{
+			name: "pagerduty without routing key",
+			values: map[string]tftypes.Value{
+				"type": tftypes.NewValue(tftypes.String, "pagerduty"),
+			},
+			wantError: true,
+		},
+		{
+			name: "slack with headers",
+			values: map[string]tftypes.Value{
+				"type": tftypes.NewValue(tftypes.String, "slack"),
+				"url":  tftypes.NewValue(tftypes.String, "https://hooks.slack.com/services/T0/B0/X"),
+				"headers": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+					"Authorization": tftypes.NewValue(tftypes.String, "Bearer token"),
+				}),
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.values["name"] = tftypes.NewValue(tftypes.String, "oncall")
+			config := resourceConfig(t, NewNotificationChannelResource(), tt.values)
+			req := resource.ValidateConfigRequest{Config: config}
+			resp := &resource.ValidateConfigResponse{}
+
+			notificationChannelConfigValidator{}.ValidateResource(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("ValidateResource() diagnostics = %v, wantError %v", resp.Diagnostics, tt.wantError)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var _ function.Function = &oracleTargetFunction{}
+
+// NewOracleTargetFunction creates a new oracle_target function
+func NewOracleTargetFunction() function.Function {
+	return &oracleTargetFunction{}
+}
+
+// oracleTargetFunction defines the function implementation
+type oracleTargetFunction struct{}
+
+// Metadata returns the function name
+func (f *oracleTargetFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "oracle_target"
+}
+
+// Definition defines the function parameters and return type
+func (f *oracleTargetFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds an Oracle target connector",
+		Description: "Returns the JSON definition of an ORACLE_TARGET connector, " +
+			"to be passed as the target argument of pipeline_configuration.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: "The name of the target.",
+			},
+			function.StringParameter{
+				Name:        "host",
+				Description: "The host name of the Oracle database.",
+			},
+			function.Int64Parameter{
+				Name:        "port",
+				Description: "The port of the Oracle listener.",
+			},
+			function.StringParameter{
+				Name:        "database",
+				Description: "The name of the Oracle database.",
+			},
+			function.StringParameter{
+				Name:        "user",
+				Description: "The user the pipeline connects as.",
+			},
+			function.StringParameter{
+				Name:           "password",
+				Description:    "The password of the user. May be null when the password is set through a connector or a secret reference.",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run builds the connector definition
+func (f *oracleTargetFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name, host, database, user string
+	var port int64
+	var password types.String
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &name, &host, &port, &database, &user, &password))
+	if resp.Error != nil {
+		return
+	}
+
+	if name == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, "name must not be empty"))
+	}
+	if host == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, "host must not be empty"))
+	}
+	if port < 1 || port > 65535 {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, fmt.Sprintf("port must be between 1 and 65535, got %d", port)))
+	}
+	if database == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(3, "database must not be empty"))
+	}
+	if user == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(4, "user must not be empty"))
+	}
+	if resp.Error != nil {
+		return
+	}
+
+	config := map[string]any{
+		"host":     host,
+		"port":     port,
+		"database": database,
+		"user":     user,
+	}
+	if !password.IsNull() {
+		config["password"] = password.ValueString()
+	}
+
+	connector, err := json.Marshal(connectorDefinition{
+		Name:   name,
+		Type:   "ORACLE_TARGET",
+		Config: config,
+	})
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Could not marshal connector: %s", err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, string(connector)))
+}
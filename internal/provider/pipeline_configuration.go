@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+// pipelineConfigurationValidator validates that each part of a pipeline is configured either
+// inline in json_configuration or through a connector or transform reference, not both
+type pipelineConfigurationValidator struct{}
+
+// Description returns a description of the validator
+func (v pipelineConfigurationValidator) Description(_ context.Context) string {
+	return "validates that source_config, target_config, smt_config and transforms are not set in json_configuration " +
+		"when source_connector_id, target_connector_id, transform_id or transforms are set"
+}
+
+// MarkdownDescription returns a markdown description of the validator
+func (v pipelineConfigurationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateResource performs the validation
+func (v pipelineConfigurationValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var jsonConfiguration, sourceConnectorID, targetConnectorID, transformID types.String
+	var transforms types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("json_configuration"), &jsonConfiguration)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("source_connector_id"), &sourceConnectorID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("target_connector_id"), &targetConnectorID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("transform_id"), &transformID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("transforms"), &transforms)...)
+	if resp.Diagnostics.HasError() || jsonConfiguration.IsNull() || jsonConfiguration.IsUnknown() {
+		return
+	}
+
+	// Invalid JSON is reported by the json_configuration validators
+	var config client.PipelineConfiguration
+	if err := json.Unmarshal([]byte(jsonConfiguration.ValueString()), &config); err != nil {
+		return
+	}
+
+	if !sourceConnectorID.IsNull() && (len(config.SourceConfig) > 0 || config.SourceType != nil) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source_connector_id"),
+			"Conflicting Source Configuration",
+			"json_configuration must not set source_type or source_config when source_connector_id is set.",
+		)
+	}
+
+	if !targetConnectorID.IsNull() && (len(config.TargetConfig) > 0 || config.TargetType != nil) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("target_connector_id"),
+			"Conflicting Target Configuration",
+			"json_configuration must not set target_type or target_config when target_connector_id is set.",
+		)
+	}
+
+	if len(config.SMTConfig) > 0 {
+		switch {
+		case !transformID.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root("transform_id"),
+				"Conflicting Transform Configuration",
+				"json_configuration must not set smt_config when transform_id is set.",
+			)
+		case !transforms.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root("transforms"),
+				"Conflicting Transform Configuration",
+				"json_configuration must not set smt_config when transforms are set.",
+			)
+		}
+	}
+
+	if len(config.Transforms) > 0 {
+		switch {
+		case !transformID.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root("transform_id"),
+				"Conflicting Transform Configuration",
+				"json_configuration must not set transforms when transform_id is set.",
+			)
+		case !transforms.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root("transforms"),
+				"Conflicting Transform Configuration",
+				"json_configuration must not set transforms when the transforms attribute is set.",
+			)
+		}
+	}
+}
+
+// configuration builds the pipeline configuration sent to the API from
+// json_configuration and the connector references
+func (m *pipelineResourceModel) configuration(ctx context.Context) (*client.PipelineConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var config client.PipelineConfiguration
+	if err := json.Unmarshal([]byte(m.JSONConfiguration.ValueString()), &config); err != nil {
+		diags.AddError(
+			"Invalid JSON Configuration",
+			fmt.Sprintf("Could not parse json_configuration: %s", err.Error()),
+		)
+		return nil, diags
+	}
+
+	config.SourceConnectorID = m.SourceConnectorID.ValueStringPointer()
+	config.SourceConnectorRevision = m.SourceConnectorRevision.ValueInt64Pointer()
+	config.TargetConnectorID = m.TargetConnectorID.ValueStringPointer()
+	config.TargetConnectorRevision = m.TargetConnectorRevision.ValueInt64Pointer()
+	config.TransformID = m.TransformID.ValueStringPointer()
+	config.TransformRevision = m.TransformRevision.ValueInt64Pointer()
+
+	// Transforms set in json_configuration, such as by the pipeline_configuration function,
+	// are kept unless the transforms attribute is set
+	if !m.Transforms.IsNull() {
+		transforms, transformDiags := transformSteps(ctx, m.Transforms)
+		diags.Append(transformDiags...)
+		config.Transforms = transforms
+	}
+
+	return &config, diags
+}
+
+// configurationChanged reports whether the configuration sent to the API differs between two models
+func (m *pipelineResourceModel) configurationChanged(other *pipelineResourceModel) bool {
+	return !m.JSONConfiguration.Equal(other.JSONConfiguration) ||
+		!m.SourceConnectorID.Equal(other.SourceConnectorID) ||
+		!m.SourceConnectorRevision.Equal(other.SourceConnectorRevision) ||
+		!m.TargetConnectorID.Equal(other.TargetConnectorID) ||
+		!m.TargetConnectorRevision.Equal(other.TargetConnectorRevision) ||
+		!m.TransformID.Equal(other.TransformID) ||
+		!m.TransformRevision.Equal(other.TransformRevision) ||
+		!m.Transforms.Equal(other.Transforms)
+}
+
+// setConfiguration populates json_configuration and the connector and transform references from the
+// configuration reported by the API, splitting them the same way configuration joins them
+func (m *pipelineResourceModel) setConfiguration(ctx context.Context, config *client.PipelineConfiguration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if config == nil {
+		m.JSONConfiguration = types.StringNull()
+		m.Transforms = types.ListNull(types.ObjectType{AttrTypes: transformStepAttrTypes()})
+		return diags
+	}
+
+	m.SourceConnectorID = types.StringPointerValue(config.SourceConnectorID)
+	m.SourceConnectorRevision = types.Int64PointerValue(config.SourceConnectorRevision)
+	m.TargetConnectorID = types.StringPointerValue(config.TargetConnectorID)
+	m.TargetConnectorRevision = types.Int64PointerValue(config.TargetConnectorRevision)
+	m.TransformID = types.StringPointerValue(config.TransformID)
+	m.TransformRevision = types.Int64PointerValue(config.TransformRevision)
+
+	// Steps of a referenced transform chain belong to the popsink_transform resource
+	var steps []client.TransformStep
+	if config.TransformID == nil {
+		steps = config.Transforms
+	}
+	transforms, transformDiags := transformStepsValue(ctx, steps)
+	diags.Append(transformDiags...)
+	m.Transforms = transforms
+
+	inline := *config
+	inline.SourceConnectorID = nil
+	inline.SourceConnectorRevision = nil
+	inline.TargetConnectorID = nil
+	inline.TargetConnectorRevision = nil
+	inline.TransformID = nil
+	inline.TransformRevision = nil
+	inline.Transforms = nil
+
+	// Round trip through a map so that keys are sorted like jsonencode sorts them,
+	// and drop empty values like normalize_config does
+	var canonical map[string]any
+	data, err := json.Marshal(inline)
+	if err == nil {
+		err = json.Unmarshal(data, &canonical)
+	}
+	if err == nil {
+		canonical, _ = normalizeConfigValue(canonical).(map[string]any)
+		data, err = json.Marshal(canonical)
+	}
+	if err != nil {
+		diags.AddError(
+			"Error Reading Pipeline Configuration",
+			fmt.Sprintf("Could not encode the pipeline configuration: %s", err.Error()),
+		)
+		return diags
+	}
+
+	m.JSONConfiguration = types.StringValue(string(data))
+
+	if paths := plaintextSecretPaths(canonical, ""); len(paths) > 0 {
+		diags.AddAttributeWarning(
+			path.Root("json_configuration"),
+			"Plaintext Secrets in Pipeline Configuration",
+			fmt.Sprintf("The configuration of pipeline %s contains plaintext secrets at %s, which are now stored in the Terraform state. "+
+				"Consider replacing them with references to popsink_secret resources ({\"secret_ref\": \"<id>\"}).",
+				m.ID.ValueString(), strings.Join(paths, ", ")),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+// parseConnectorDefinition decodes a connector returned by a connector function
+// and checks that its type is one of validTypes
+func parseConnectorDefinition(definition string, validTypes []string) (*connectorDefinition, error) {
+	var connector connectorDefinition
+	if err := json.Unmarshal([]byte(definition), &connector); err != nil {
+		return nil, fmt.Errorf("could not parse connector: %w", err)
+	}
+
+	if !slices.Contains(validTypes, connector.Type) {
+		return nil, fmt.Errorf("connector type must be one of %v, got %q", validTypes, connector.Type)
+	}
+
+	return &connector, nil
+}
+
+// transformStepFromArguments validates the arguments of a transform given as a
+// map of strings and converts it to its API representation
+func transformStepFromArguments(arguments map[string]string) (client.TransformStep, []string) {
+	var problems []string
+
+	for name := range arguments {
+		if name != "type" && !slices.Contains(transformStepArguments, name) {
+			problems = append(problems, fmt.Sprintf("unknown argument %s", name))
+		}
+	}
+	slices.Sort(problems)
+
+	argument := func(name string) types.String {
+		if value, ok := arguments[name]; ok {
+			return types.StringValue(value)
+		}
+		return types.StringNull()
+	}
+
+	step := transformStepModel{
+		Type:             argument("type"),
+		Condition:        argument("condition"),
+		Field:            argument("field"),
+		NewName:          argument("new_name"),
+		Replacement:      argument("replacement"),
+		ToType:           argument("to_type"),
+		TopicRegex:       argument("topic_regex"),
+		TopicReplacement: argument("topic_replacement"),
+	}
+
+	spec, ok := transformStepSpecs[step.Type.ValueString()]
+	if !ok {
+		problems = append(problems, fmt.Sprintf("type must be one of %v, got %q", validTransformTypes, step.Type.ValueString()))
+		return step.transformStep(), problems
+	}
+
+	subject := step.Type.ValueString() + " transforms"
+	for _, d := range spec.validate(path.Empty(), "Transform", subject, transformStepArguments, step.arguments()) {
+		problems = append(problems, d.Detail())
+	}
+
+	if !step.ToType.IsNull() && !slices.Contains(validCastTypes, step.ToType.ValueString()) {
+		problems = append(problems, fmt.Sprintf("to_type must be one of %v, got %q", validCastTypes, step.ToType.ValueString()))
+	}
+
+	if !step.TopicRegex.IsNull() {
+		if _, err := regexp.Compile(step.TopicRegex.ValueString()); err != nil {
+			problems = append(problems, fmt.Sprintf("topic_regex must be a valid regular expression: %s", err.Error()))
+		}
+	}
+
+	return step.transformStep(), problems
+}
+
+// Ensure the implementation satisfies the expected interfaces
+var _ function.Function = &pipelineConfigurationFunction{}
+
+// NewPipelineConfigurationFunction creates a new pipeline_configuration function
+func NewPipelineConfigurationFunction() function.Function {
+	return &pipelineConfigurationFunction{}
+}
+
+// pipelineConfigurationFunction defines the function implementation
+type pipelineConfigurationFunction struct{}
+
+// Metadata returns the function name
+func (f *pipelineConfigurationFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "pipeline_configuration"
+}
+
+// Definition defines the function parameters and return type
+func (f *pipelineConfigurationFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds a pipeline configuration",
+		Description: "Returns the canonical JSON configuration of a pipeline, to be used as the json_configuration " +
+			"of popsink_pipeline, from a source connector, a target connector and an ordered list of transforms.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "source",
+				Description: "The source connector, as returned by a source connector function such as kafka_source.",
+			},
+			function.StringParameter{
+				Name:        "target",
+				Description: "The target connector, as returned by a target connector function such as oracle_target.",
+			},
+			function.ListParameter{
+				Name: "transforms",
+				Description: "Ordered transforms applied to the records. Each transform is an object with a type " +
+					"and the arguments of the popsink_transform steps used by that type. May be null or empty.",
+				ElementType:    types.MapType{ElemType: types.StringType},
+				AllowNullValue: true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run builds the pipeline configuration
+func (f *pipelineConfigurationFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var sourceDefinition, targetDefinition string
+	var transforms []map[string]string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &sourceDefinition, &targetDefinition, &transforms))
+	if resp.Error != nil {
+		return
+	}
+
+	source, err := parseConnectorDefinition(sourceDefinition, validSourceConnectorTypes)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("Invalid source: %s", err.Error())))
+	}
+
+	target, err := parseConnectorDefinition(targetDefinition, validTargetConnectorTypes)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, fmt.Sprintf("Invalid target: %s", err.Error())))
+	}
+
+	var steps []client.TransformStep
+	for i, arguments := range transforms {
+		step, problems := transformStepFromArguments(arguments)
+		for _, problem := range problems {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, fmt.Sprintf("Invalid transforms[%d]: %s", i, problem)))
+		}
+		steps = append(steps, step)
+	}
+
+	if resp.Error != nil {
+		return
+	}
+
+	configuration, err := json.Marshal(client.PipelineConfiguration{
+		SourceName:   source.Name,
+		SourceType:   &source.Type,
+		SourceConfig: source.Config,
+		TargetName:   target.Name,
+		TargetType:   &target.Type,
+		TargetConfig: target.Config,
+		SMTConfig:    []any{},
+		Transforms:   steps,
+	})
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Could not marshal pipeline configuration: %s", err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, string(configuration)))
+}
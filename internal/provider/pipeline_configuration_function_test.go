@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+// runFunction calls a provider function with the given arguments and returns its string result
+func runFunction(t *testing.T, f function.Function, arguments ...attr.Value) (string, *function.FuncError) {
+	t.Helper()
+
+	resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	f.Run(context.Background(), function.RunRequest{Arguments: function.NewArgumentsData(arguments)}, resp)
+	if resp.Error != nil {
+		return "", resp.Error
+	}
+
+	return resp.Result.Value().(types.String).ValueString(), nil
+}
+
+// transformArguments builds the transforms argument of pipeline_configuration
+func transformArguments(t *testing.T, steps ...map[string]string) types.List {
+	t.Helper()
+
+	value, diags := types.ListValueFrom(context.Background(), types.MapType{ElemType: types.StringType}, steps)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	return value
+}
+
+func TestPipelineConfigurationFunction(t *testing.T) {
+	source, funcErr := runFunction(t, NewKafkaSourceFunction(),
+		types.StringValue("orders-source"),
+		types.StringValue("kafka-1.example.com:9092,kafka-2.example.com:9092"),
+		types.StringValue("orders"),
+		types.StringValue("popsink-orders"),
+	)
+	if funcErr != nil {
+		t.Fatalf("kafka_source: unexpected error: %v", funcErr)
+	}
+
+	target, funcErr := runFunction(t, NewOracleTargetFunction(),
+		types.StringValue("warehouse"),
+		types.StringValue("oracle.example.com"),
+		types.Int64Value(1521),
+		types.StringValue("PROD"),
+		types.StringValue("popsink"),
+		types.StringNull(),
+	)
+	if funcErr != nil {
+		t.Fatalf("oracle_target: unexpected error: %v", funcErr)
+	}
+
+	result, funcErr := runFunction(t, NewPipelineConfigurationFunction(),
+		types.StringValue(source),
+		types.StringValue(target),
+		transformArguments(t, map[string]string{"type": "mask", "field": "card_number"}),
+	)
+	if funcErr != nil {
+		t.Fatalf("pipeline_configuration: unexpected error: %v", funcErr)
+	}
+
+	var config client.PipelineConfiguration
+	if err := json.Unmarshal([]byte(result), &config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if config.SourceType == nil || *config.SourceType != "KAFKA_SOURCE" || config.SourceConfig["topic"] != "orders" {
+		t.Errorf("unexpected source in %s", result)
+	}
+
+	if config.TargetType == nil || *config.TargetType != "ORACLE_TARGET" || config.TargetConfig["port"] != float64(1521) {
+		t.Errorf("unexpected target in %s", result)
+	}
+
+	if _, ok := config.TargetConfig["password"]; ok {
+		t.Errorf("expected no password for a null password, got %s", result)
+	}
+
+	if len(config.Transforms) != 1 || config.Transforms[0].Type != "mask" {
+		t.Errorf("unexpected transforms in %s", result)
+	}
+
+	// The transforms reach the API when the result is used as json_configuration
+	model := pipelineResourceModel{
+		JSONConfiguration: types.StringValue(result),
+		Transforms:        types.ListNull(types.ObjectType{AttrTypes: transformStepAttrTypes()}),
+	}
+	apiConfig, diags := model.configuration(context.Background())
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(apiConfig.Transforms) != 1 || apiConfig.Transforms[0].Field == nil || *apiConfig.Transforms[0].Field != "card_number" {
+		t.Errorf("expected the mask transform to be sent to the API, got %v", apiConfig.Transforms)
+	}
+}
+
+func TestPipelineConfigurationFunction_Errors(t *testing.T) {
+	source := `{"name":"orders-source","type":"KAFKA_SOURCE","config":{"topic":"orders"}}`
+	target := `{"name":"warehouse","type":"ORACLE_TARGET","config":{"host":"oracle.example.com"}}`
+
+	tests := []struct {
+		name       string
+		source     string
+		target     string
+		transforms types.List
+		argument   int64
+	}{
+		{
+			name:       "target as source",
+			source:     target,
+			target:     target,
+			transforms: types.ListNull(types.MapType{ElemType: types.StringType}),
+			argument:   0,
+		},
+		{
+			name:       "malformed target",
+			source:     source,
+			target:     "not json",
+			transforms: types.ListNull(types.MapType{ElemType: types.StringType}),
+			argument:   1,
+		},
+		{
+			name:       "missing transform argument",
+			source:     source,
+			target:     target,
+			transforms: transformArguments(t, map[string]string{"type": "rename_field", "field": "amt"}),
+			argument:   2,
+		},
+		{
+			name:       "unknown transform argument",
+			source:     source,
+			target:     target,
+			transforms: transformArguments(t, map[string]string{"type": "drop_field", "field": "amt", "colour": "blue"}),
+			argument:   2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, funcErr := runFunction(t, NewPipelineConfigurationFunction(),
+				types.StringValue(tt.source),
+				types.StringValue(tt.target),
+				tt.transforms,
+			)
+			if funcErr == nil {
+				t.Fatalf("expected an error")
+			}
+
+			if funcErr.FunctionArgument == nil || *funcErr.FunctionArgument != tt.argument {
+				t.Errorf("expected an error on argument %d, got %v", tt.argument, funcErr)
+			}
+		})
+	}
+}
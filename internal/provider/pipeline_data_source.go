@@ -0,0 +1,256 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+// redactedPipelineConfiguration returns the pipeline configuration as a JSON
+// string with the values of sensitive keys masked
+func redactedPipelineConfiguration(config *client.PipelineConfiguration) (string, error) {
+	configJSON, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+
+	var configMap map[string]any
+	if err := json.Unmarshal(configJSON, &configMap); err != nil {
+		return "", err
+	}
+
+	redactedJSON, err := json.Marshal(maskSecrets(configMap))
+	if err != nil {
+		return "", err
+	}
+
+	return string(redactedJSON), nil
+}
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ datasource.DataSource                     = &pipelineDataSource{}
+	_ datasource.DataSourceWithConfigure        = &pipelineDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &pipelineDataSource{}
+)
+
+// NewPipelineDataSource creates a new pipeline data source
+func NewPipelineDataSource() datasource.DataSource {
+	return &pipelineDataSource{}
+}
+
+// pipelineDataSource defines the data source implementation
+type pipelineDataSource struct {
+	client *client.Client
+}
+
+// pipelineDataSourceModel describes the data source data model
+type pipelineDataSourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	TeamID            types.String `tfsdk:"team_id"`
+	TeamName          types.String `tfsdk:"team_name"`
+	State             types.String `tfsdk:"state"`
+	JSONConfiguration types.String `tfsdk:"json_configuration"`
+	LastError         types.String `tfsdk:"last_error"`
+	StatusUpdatedAt   types.String `tfsdk:"status_updated_at"`
+	RecordsProcessed  types.Int64  `tfsdk:"records_processed"`
+	ConsumerLag       types.Int64  `tfsdk:"consumer_lag"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+	UpdatedAt         types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the data source type name
+func (d *pipelineDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pipeline"
+}
+
+// Schema defines the data source schema
+func (d *pipelineDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads a Popsink pipeline by ID, or by name within a team, including its live configuration and runtime status.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the pipeline. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the pipeline. Requires team_id. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"team_id": schema.StringAttribute{
+				Description: "The UUID of the team that owns the pipeline. Required when looking up by name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"team_name": schema.StringAttribute{
+				Description: "The name of the team that owns the pipeline.",
+				Computed:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "The state of the pipeline: draft, paused, live, error or building.",
+				Computed:    true,
+			},
+			"json_configuration": schema.StringAttribute{
+				Description: "The server-side pipeline configuration as a JSON string, with secret values masked.",
+				Computed:    true,
+			},
+			"last_error": schema.StringAttribute{
+				Description: "The last error reported by the pipeline runtime, if any.",
+				Computed:    true,
+			},
+			"status_updated_at": schema.StringAttribute{
+				Description: "The RFC 3339 timestamp of the last pipeline status change.",
+				Computed:    true,
+			},
+			"records_processed": schema.Int64Attribute{
+				Description: "The number of records processed by the pipeline.",
+				Computed:    true,
+			},
+			"consumer_lag": schema.Int64Attribute{
+				Description: "The current consumer lag of the pipeline source, in messages.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The RFC 3339 timestamp at which the pipeline was created.",
+				Computed:    true,
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The RFC 3339 timestamp at which the pipeline was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source
+func (d *pipelineDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// ConfigValidators returns the data source-level configuration validators
+func (d *pipelineDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+		datasourcevalidator.RequiredTogether(path.MatchRoot("name"), path.MatchRoot("team_id")),
+	}
+}
+
+// Read refreshes the data source state
+func (d *pipelineDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config pipelineDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var pipeline *client.PipelineRead
+	if !config.ID.IsNull() {
+		var err error
+		pipeline, err = d.client.GetPipeline(ctx, config.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Pipeline",
+				fmt.Sprintf("Could not read pipeline %s: %s", config.ID.ValueString(), err.Error()),
+			)
+			return
+		}
+
+		if pipeline == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Pipeline Not Found",
+				fmt.Sprintf("No pipeline has the ID %s.", config.ID.ValueString()),
+			)
+			return
+		}
+	} else {
+		name := config.Name.ValueString()
+		teamID := config.TeamID.ValueString()
+		pipelines, err := d.client.ListPipelines(ctx, client.PipelineFilter{TeamID: teamID, Name: name})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Listing Pipelines",
+				fmt.Sprintf("Could not list pipelines: %s", err.Error()),
+			)
+			return
+		}
+
+		// The API filter may match partially, so only keep exact matches
+		var ids []string
+		for i := range pipelines {
+			if pipelines[i].Name == name && pipelines[i].TeamID == teamID {
+				pipeline = &pipelines[i]
+				ids = append(ids, pipelines[i].ID)
+			}
+		}
+
+		switch len(ids) {
+		case 0:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Pipeline Not Found",
+				fmt.Sprintf("No pipeline of team %s is named %q.", teamID, name),
+			)
+			return
+		case 1:
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Multiple Pipelines Found",
+				fmt.Sprintf("%d pipelines of team %s are named %q: %s. Use id to select one.", len(ids), teamID, name, strings.Join(ids, ", ")),
+			)
+			return
+		}
+	}
+
+	config.ID = types.StringValue(pipeline.ID)
+	config.Name = types.StringValue(pipeline.Name)
+	config.TeamID = types.StringValue(pipeline.TeamID)
+	config.TeamName = types.StringValue(pipeline.TeamName)
+	config.State = types.StringValue(string(pipeline.State))
+	config.JSONConfiguration = types.StringNull()
+	config.LastError = types.StringPointerValue(pipeline.LastError)
+	config.StatusUpdatedAt = types.StringPointerValue(pipeline.StatusUpdatedAt)
+	config.RecordsProcessed = types.Int64PointerValue(pipeline.RecordsProcessed)
+	config.ConsumerLag = types.Int64PointerValue(pipeline.ConsumerLag)
+	config.CreatedAt = types.StringPointerValue(pipeline.CreatedAt)
+	config.UpdatedAt = types.StringPointerValue(pipeline.UpdatedAt)
+
+	if pipeline.JSONConfiguration != nil {
+		configJSON, err := redactedPipelineConfiguration(pipeline.JSONConfiguration)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Marshaling Pipeline Configuration",
+				fmt.Sprintf("Could not marshal pipeline configuration: %s", err.Error()),
+			)
+			return
+		}
+		config.JSONConfiguration = types.StringValue(configJSON)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+func TestRedactedPipelineConfiguration(t *testing.T) {
+	config := &client.PipelineConfiguration{
+		SourceName: "kafka-source",
+		SourceConfig: map[string]any{
+			"bootstrap_server": "kafka.example.com:9092",
+			"sasl_password":    "hunter2",
+		},
+		TargetName: "oracle-target",
+		TargetConfig: map[string]any{
+			"host":     "oracle.example.com",
+			"password": map[string]any{"secret_ref": "secret-123"},
+		},
+		SMTConfig: []any{
+			map[string]any{"type": "mask_field", "api_key": "leak"},
+		},
+	}
+
+	redacted, err := redactedPipelineConfiguration(config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got client.PipelineConfiguration
+	if err := json.Unmarshal([]byte(redacted), &got); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got.SourceConfig["sasl_password"] != maskedValue {
+		t.Errorf("expected sasl_password to be masked, got %v", got.SourceConfig["sasl_password"])
+	}
+	if got.SourceConfig["bootstrap_server"] != "kafka.example.com:9092" {
+		t.Errorf("expected bootstrap_server to be kept, got %v", got.SourceConfig["bootstrap_server"])
+	}
+	if ref, ok := secretRef(got.TargetConfig["password"]); !ok || ref != "secret-123" {
+		t.Errorf("expected the secret reference to be kept, got %v", got.TargetConfig["password"])
+	}
+	if len(got.SMTConfig) != 1 {
+		t.Fatalf("expected 1 SMT, got %v", got.SMTConfig)
+	}
+	smt, _ := got.SMTConfig[0].(map[string]any)
+	if smt["api_key"] != maskedValue {
+		t.Errorf("expected the SMT api_key to be masked, got %v", smt["api_key"])
+	}
+	if smt["type"] != "mask_field" {
+		t.Errorf("expected the SMT type to be kept, got %v", smt["type"])
+	}
+}
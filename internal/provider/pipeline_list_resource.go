@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ list.ListResource              = &pipelineListResource{}
+	_ list.ListResourceWithConfigure = &pipelineListResource{}
+)
+
+// NewPipelineListResource creates a new pipeline list resource
+func NewPipelineListResource() list.ListResource {
+	return &pipelineListResource{}
+}
+
+// pipelineListResource defines the list resource implementation
+type pipelineListResource struct {
+	client *client.Client
+}
+
+// pipelineListResourceModel describes the list resource configuration model
+type pipelineListResourceModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+	TeamID    types.String `tfsdk:"team_id"`
+	State     types.String `tfsdk:"state"`
+}
+
+// Metadata returns the resource type name
+func (r *pipelineListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pipeline"
+}
+
+// ListResourceConfigSchema defines the list resource configuration schema
+func (r *pipelineListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the Popsink pipelines of the organization.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Description: "Only list pipelines whose name matches this regular expression.",
+				Optional:    true,
+				Validators: []validator.String{
+					regexpValidator{},
+				},
+			},
+			"team_id": schema.StringAttribute{
+				Description: "Only list pipelines owned by this team.",
+				Optional:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "Only list pipelines in this state. Valid values: draft, paused, live, error, building.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(validPipelineStates...),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the list resource
+func (r *pipelineListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// List streams the pipelines matching the configuration
+func (r *pipelineListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config pipelineListResourceModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	nameFilter, err := nameRegexp(config.NameRegex)
+	if err != nil {
+		diags.AddError(
+			"Invalid Regular Expression",
+			fmt.Sprintf("Could not compile name_regex: %s", err.Error()),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	pipelines, err := r.client.ListPipelines(ctx, client.PipelineFilter{
+		TeamID: config.TeamID.ValueString(),
+		State:  client.PipelineState(config.State.ValueString()),
+	})
+	if err != nil {
+		diags.AddError(
+			"Error Listing Pipelines",
+			fmt.Sprintf("Could not list pipelines: %s", err.Error()),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for i := range pipelines {
+			pipeline := &pipelines[i]
+			if nameFilter != nil && !nameFilter.MatchString(pipeline.Name) {
+				continue
+			}
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			count++
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = fmt.Sprintf("%s/%s", pipeline.TeamName, pipeline.Name)
+			result.Diagnostics.Append(setResourceIdentity(ctx, r.client, result.Identity, types.StringValue(pipeline.ID))...)
+
+			if req.IncludeResource {
+				model := pipelineResourceModel{
+					ID:       types.StringValue(pipeline.ID),
+					Name:     types.StringValue(pipeline.Name),
+					TeamID:   types.StringValue(pipeline.TeamID),
+					TeamName: types.StringValue(pipeline.TeamName),
+					State:    types.StringValue(string(pipeline.State)),
+				}
+				model.setRuntimeStatus(pipeline)
+				result.Diagnostics.Append(model.setConfiguration(ctx, pipeline.JSONConfiguration)...)
+				result.Diagnostics.Append(result.Resource.Set(ctx, &model)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
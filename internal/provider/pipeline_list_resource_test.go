@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+func TestPipelineListResource_List(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/me" {
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(client.IdentityRead{OrganizationID: "org-123"})
+			return
+		}
+
+		if r.URL.Query().Get("state") != "error" {
+			t.Errorf("expected state filter error, got %s", r.URL.Query().Get("state"))
+		}
+
+		response := client.Page[client.PipelineRead]{
+			Items: []client.PipelineRead{
+				{ID: "pipeline-1", Name: "orders-sync", TeamID: "team-1", TeamName: "data", State: "error"},
+				{ID: "pipeline-2", Name: "audit-export", TeamID: "team-1", TeamName: "data", State: "error"},
+				{ID: "pipeline-3", Name: "orders-backfill", TeamID: "team-1", TeamName: "data", State: "error"},
+			},
+			Total: 3,
+			Page:  1,
+			Size:  100,
+			Pages: 1,
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	r := &pipelineListResource{client: client.NewClient(server.URL, "test-token")}
+
+	configSchemaResp := &list.ListResourceSchemaResponse{}
+	r.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, configSchemaResp)
+	schemaResp := &resource.SchemaResponse{}
+	NewPipelineResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	identityResp := &resource.IdentitySchemaResponse{}
+	NewPipelineResource().(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, identityResp)
+
+	configType := configSchemaResp.Schema.Type().TerraformType(ctx)
+	req := list.ListRequest{
+		Config: tfsdk.Config{
+			Schema: configSchemaResp.Schema,
+			Raw: tftypes.NewValue(configType, map[string]tftypes.Value{
+				"name_regex": tftypes.NewValue(tftypes.String, "^orders-"),
+				"team_id":    tftypes.NewValue(tftypes.String, nil),
+				"state":      tftypes.NewValue(tftypes.String, "error"),
+			}),
+		},
+		IncludeResource:        true,
+		Limit:                  1,
+		ResourceSchema:         schemaResp.Schema,
+		ResourceIdentitySchema: identityResp.IdentitySchema,
+	}
+
+	stream := &list.ListResultsStream{}
+	r.List(ctx, req, stream)
+
+	var results []list.ListResult
+	for result := range stream.Results {
+		results = append(results, result)
+	}
+
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+	if results[0].Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", results[0].Diagnostics)
+	}
+	if results[0].DisplayName != "data/orders-sync" {
+		t.Errorf("expected display name data/orders-sync, got %s", results[0].DisplayName)
+	}
+
+	var identity resourceIdentityModel
+	results[0].Identity.Get(ctx, &identity)
+	if identity.ID.ValueString() != "pipeline-1" || identity.OrgID.ValueString() != "org-123" {
+		t.Errorf("expected identity pipeline-1 in org-123, got %s in %s", identity.ID.ValueString(), identity.OrgID.ValueString())
+	}
+
+	var model pipelineResourceModel
+	results[0].Resource.Get(ctx, &model)
+	if model.TeamID.ValueString() != "team-1" {
+		t.Errorf("expected team_id team-1, got %s", model.TeamID.ValueString())
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -87,9 +88,13 @@ func (v jsonConnectorTypeValidator) ValidateString(ctx context.Context, request
 
 // Ensure the implementation satisfies the expected interfaces
 var (
-	_ resource.Resource                = &pipelineResource{}
-	_ resource.ResourceWithConfigure   = &pipelineResource{}
-	_ resource.ResourceWithImportState = &pipelineResource{}
+	_ resource.Resource                     = &pipelineResource{}
+	_ resource.ResourceWithConfigure        = &pipelineResource{}
+	_ resource.ResourceWithImportState      = &pipelineResource{}
+	_ resource.ResourceWithIdentity         = &pipelineResource{}
+	_ resource.ResourceWithUpgradeState     = &pipelineResource{}
+	_ resource.ResourceWithMoveState        = &pipelineResource{}
+	_ resource.ResourceWithConfigValidators = &pipelineResource{}
 )
 
 // NewPipelineResource creates a new pipeline resource
@@ -104,12 +109,35 @@ type pipelineResource struct {
 
 // pipelineResourceModel describes the resource data model
 type pipelineResourceModel struct {
-	ID                types.String `tfsdk:"id"`
-	Name              types.String `tfsdk:"name"`
-	TeamID            types.String `tfsdk:"team_id"`
-	TeamName          types.String `tfsdk:"team_name"`
-	State             types.String `tfsdk:"state"`
-	JSONConfiguration types.String `tfsdk:"json_configuration"`
+	ID                      types.String `tfsdk:"id"`
+	Name                    types.String `tfsdk:"name"`
+	TeamID                  types.String `tfsdk:"team_id"`
+	TeamName                types.String `tfsdk:"team_name"`
+	State                   types.String `tfsdk:"state"`
+	JSONConfiguration       types.String `tfsdk:"json_configuration"`
+	SourceConnectorID       types.String `tfsdk:"source_connector_id"`
+	SourceConnectorRevision types.Int64  `tfsdk:"source_connector_revision"`
+	TargetConnectorID       types.String `tfsdk:"target_connector_id"`
+	TargetConnectorRevision types.Int64  `tfsdk:"target_connector_revision"`
+	TransformID             types.String `tfsdk:"transform_id"`
+	TransformRevision       types.Int64  `tfsdk:"transform_revision"`
+	Transforms              types.List   `tfsdk:"transforms"`
+	LastError               types.String `tfsdk:"last_error"`
+	StatusUpdatedAt         types.String `tfsdk:"status_updated_at"`
+	RecordsProcessed        types.Int64  `tfsdk:"records_processed"`
+	ConsumerLag             types.Int64  `tfsdk:"consumer_lag"`
+	CreatedAt               types.String `tfsdk:"created_at"`
+	UpdatedAt               types.String `tfsdk:"updated_at"`
+}
+
+// setRuntimeStatus copies the runtime status and timestamps reported by the API into the model
+func (m *pipelineResourceModel) setRuntimeStatus(pipeline *client.PipelineRead) {
+	m.LastError = types.StringPointerValue(pipeline.LastError)
+	m.StatusUpdatedAt = types.StringPointerValue(pipeline.StatusUpdatedAt)
+	m.RecordsProcessed = types.Int64PointerValue(pipeline.RecordsProcessed)
+	m.ConsumerLag = types.Int64PointerValue(pipeline.ConsumerLag)
+	m.CreatedAt = types.StringPointerValue(pipeline.CreatedAt)
+	m.UpdatedAt = types.StringPointerValue(pipeline.UpdatedAt)
 }
 
 // Valid connector types based on the OpenAPI schema
@@ -136,6 +164,7 @@ func (r *pipelineResource) Metadata(ctx context.Context, req resource.MetadataRe
 // Schema defines the resource schema
 func (r *pipelineResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     1,
 		Description: "Manages a Popsink pipeline resource.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -173,10 +202,82 @@ func (r *pipelineResource) Schema(ctx context.Context, req resource.SchemaReques
 					jsonConnectorTypeValidator{},
 				},
 			},
+			"source_connector_id": schema.StringAttribute{
+				Description: "The UUID of a popsink_source_connector to read from instead of the source_config of json_configuration.",
+				Optional:    true,
+			},
+			"source_connector_revision": schema.Int64Attribute{
+				Description: "The revision of the source connector the pipeline is deployed with. " +
+					"Set it to the connector revision attribute so that connector changes redeploy the pipeline.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("source_connector_id")),
+				},
+			},
+			"target_connector_id": schema.StringAttribute{
+				Description: "The UUID of a popsink_target_connector to write to instead of the target_config of json_configuration.",
+				Optional:    true,
+			},
+			"target_connector_revision": schema.Int64Attribute{
+				Description: "The revision of the target connector the pipeline is deployed with. " +
+					"Set it to the connector revision attribute so that connector changes redeploy the pipeline.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("target_connector_id")),
+				},
+			},
+			"transform_id": schema.StringAttribute{
+				Description: "The UUID of a popsink_transform chain applied to the records instead of the smt_config of json_configuration.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("transforms")),
+				},
+			},
+			"transform_revision": schema.Int64Attribute{
+				Description: "The revision of the transform chain the pipeline is deployed with. " +
+					"Set it to the transform revision attribute so that transform changes redeploy the pipeline.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("transform_id")),
+				},
+			},
+			"transforms": transformStepsAttribute("Ordered transforms applied to the records instead of the smt_config of json_configuration.", false),
+			"last_error": schema.StringAttribute{
+				Description: "The last error reported by the pipeline runtime, if any.",
+				Computed:    true,
+			},
+			"status_updated_at": schema.StringAttribute{
+				Description: "The RFC 3339 timestamp of the last pipeline status change.",
+				Computed:    true,
+			},
+			"records_processed": schema.Int64Attribute{
+				Description: "The number of records processed by the pipeline.",
+				Computed:    true,
+			},
+			"consumer_lag": schema.Int64Attribute{
+				Description: "The current consumer lag of the pipeline source, in messages.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The RFC 3339 timestamp at which the pipeline was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The RFC 3339 timestamp at which the pipeline was last updated.",
+				Computed:    true,
+			},
 		},
 	}
 }
 
+// IdentitySchema defines the resource identity schema
+func (r *pipelineResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema("pipeline")
+}
+
 // Configure adds the provider configured client to the resource
 func (r *pipelineResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -195,6 +296,14 @@ func (r *pipelineResource) Configure(ctx context.Context, req resource.Configure
 	r.client = client
 }
 
+// ConfigValidators returns the resource-level configuration validators
+func (r *pipelineResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		pipelineConfigurationValidator{},
+		transformStepsConfigValidator{attribute: "transforms"},
+	}
+}
+
 // Create creates the resource
 func (r *pipelineResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan pipelineResourceModel
@@ -203,13 +312,10 @@ func (r *pipelineResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	// Parse JSON configuration
-	var config client.PipelineConfiguration
-	if err := json.Unmarshal([]byte(plan.JSONConfiguration.ValueString()), &config); err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid JSON Configuration",
-			fmt.Sprintf("Could not parse json_configuration: %s", err.Error()),
-		)
+	// Build the configuration from json_configuration and the connector references
+	config, diags := plan.configuration(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -218,7 +324,7 @@ func (r *pipelineResource) Create(ctx context.Context, req resource.CreateReques
 		Name:              plan.Name.ValueString(),
 		TeamID:            plan.TeamID.ValueString(),
 		State:             client.PipelineState(plan.State.ValueString()),
-		JSONConfiguration: &config,
+		JSONConfiguration: config,
 	}
 
 	pipeline, err := r.client.CreatePipeline(ctx, createReq)
@@ -236,6 +342,7 @@ func (r *pipelineResource) Create(ctx context.Context, req resource.CreateReques
 	plan.TeamID = types.StringValue(pipeline.TeamID)
 	plan.TeamName = types.StringValue(pipeline.TeamName)
 	plan.State = types.StringValue(string(pipeline.State))
+	plan.setRuntimeStatus(pipeline)
 
 	// Keep the original configuration from the plan instead of using API response
 	// The API may return a different format or incomplete configuration
@@ -244,6 +351,7 @@ func (r *pipelineResource) Create(ctx context.Context, req resource.CreateReques
 	tflog.Info(ctx, "Created pipeline", map[string]any{"id": pipeline.ID})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, r.client, resp.Identity, plan.ID)...)
 }
 
 // Read refreshes the resource state
@@ -274,12 +382,16 @@ func (r *pipelineResource) Read(ctx context.Context, req resource.ReadRequest, r
 	state.TeamID = types.StringValue(pipeline.TeamID)
 	state.TeamName = types.StringValue(pipeline.TeamName)
 	state.State = types.StringValue(string(pipeline.State))
+	state.setRuntimeStatus(pipeline)
 
-	// Keep the original configuration from the state instead of using API response
-	// The API may return a different format or incomplete configuration
-	// state.JSONConfiguration already contains the current configuration
+	// Keep the configuration from the state, whose formatting the API does not preserve,
+	// unless there is none yet, such as after an import
+	if state.JSONConfiguration.IsNull() {
+		resp.Diagnostics.Append(state.setConfiguration(ctx, pipeline.JSONConfiguration)...)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, r.client, resp.Identity, state.ID)...)
 }
 
 // Update updates the resource
@@ -296,13 +408,10 @@ func (r *pipelineResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	// Parse JSON configuration
-	var config client.PipelineConfiguration
-	if err := json.Unmarshal([]byte(plan.JSONConfiguration.ValueString()), &config); err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid JSON Configuration",
-			fmt.Sprintf("Could not parse json_configuration: %s", err.Error()),
-		)
+	// Build the configuration from json_configuration and the connector references
+	config, diags := plan.configuration(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -324,8 +433,8 @@ func (r *pipelineResource) Update(ctx context.Context, req resource.UpdateReques
 		updateReq.State = &pipelineState
 	}
 
-	if !plan.JSONConfiguration.Equal(state.JSONConfiguration) {
-		updateReq.JSONConfiguration = &config
+	if plan.configurationChanged(&state) {
+		updateReq.JSONConfiguration = config
 	}
 
 	// Update pipeline
@@ -344,6 +453,7 @@ func (r *pipelineResource) Update(ctx context.Context, req resource.UpdateReques
 	plan.TeamID = types.StringValue(pipeline.TeamID)
 	plan.TeamName = types.StringValue(pipeline.TeamName)
 	plan.State = types.StringValue(string(pipeline.State))
+	plan.setRuntimeStatus(pipeline)
 
 	// Keep the original configuration from the plan instead of using API response
 	// The API may return a different format or incomplete configuration
@@ -352,6 +462,7 @@ func (r *pipelineResource) Update(ctx context.Context, req resource.UpdateReques
 	tflog.Info(ctx, "Updated pipeline", map[string]any{"id": pipeline.ID})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, r.client, resp.Identity, plan.ID)...)
 }
 
 // Delete deletes the resource
@@ -374,7 +485,46 @@ func (r *pipelineResource) Delete(ctx context.Context, req resource.DeleteReques
 	tflog.Info(ctx, "Deleted pipeline", map[string]any{"id": state.ID.ValueString()})
 }
 
-// ImportState imports the resource state
+// ImportState imports the resource state from an identity or an ID of the form pipeline_id or team_name/pipeline_name
 func (r *pipelineResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if req.ID == "" {
+		importStateFromIdentity(ctx, r.client, req, resp)
+		return
+	}
+
+	team, pipeline := splitImportID(req.ID)
+	id, err := resolvePipelineID(ctx, r.client, team, pipeline)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Pipeline",
+			fmt.Sprintf("Could not resolve import ID %q: %s", req.ID, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// UpgradeState upgrades states written with prior schema versions
+func (r *pipelineResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   pipelineSchemaV0(),
+			StateUpgrader: upgradeStateV0(pipelineModelFromV0),
+		},
+	}
+}
+
+// MoveState moves the state of the same resource type from another provider, such as a fork of this provider
+func (r *pipelineResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			SourceSchema: pipelineSchemaV0(),
+			StateMover:   moveStateV0("popsink_pipeline", pipelineModelFromV0),
+		},
+		{
+			SourceSchema: currentSchema(ctx, r),
+			StateMover:   moveStateAsIs[pipelineResourceModel]("popsink_pipeline", 1),
+		},
+	}
 }
@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+func TestPipelineConfigurationValidator(t *testing.T) {
+	tests := []struct {
+		name      string
+		values    map[string]tftypes.Value
+		wantError bool
+	}{
+		{
+			name: "inline configuration",
+			values: map[string]tftypes.Value{
+				"json_configuration": tftypes.NewValue(tftypes.String, `{"source_type":"KAFKA_SOURCE","source_config":{"topic":"orders"}}`),
+			},
+			wantError: false,
+		},
+		{
+			name: "connector references",
+			values: map[string]tftypes.Value{
+				"json_configuration":  tftypes.NewValue(tftypes.String, `{"smt_name":"orders","smt_config":[]}`),
+				"source_connector_id": tftypes.NewValue(tftypes.String, "source-123"),
+				"target_connector_id": tftypes.NewValue(tftypes.String, "target-123"),
+			},
+			wantError: false,
+		},
+		{
+			name: "source connector with inline source configuration",
+			values: map[string]tftypes.Value{
+				"json_configuration":  tftypes.NewValue(tftypes.String, `{"source_config":{"topic":"orders"}}`),
+				"source_connector_id": tftypes.NewValue(tftypes.String, "source-123"),
+			},
+			wantError: true,
+		},
+		{
+			name: "target connector with inline target type",
+			values: map[string]tftypes.Value{
+				"json_configuration":  tftypes.NewValue(tftypes.String, `{"target_type":"ORACLE_TARGET"}`),
+				"target_connector_id": tftypes.NewValue(tftypes.String, "target-123"),
+			},
+			wantError: true,
+		},
+		{
+			name: "inline transforms with smt_config",
+			values: map[string]tftypes.Value{
+				"json_configuration": tftypes.NewValue(tftypes.String, `{"smt_config":[{"function_type":"mapper"}]}`),
+				"transforms":         transformStepList(map[string]string{"type": "drop_field", "field": "internal_id"}),
+			},
+			wantError: true,
+		},
+		{
+			name: "transform chain with transforms in json_configuration",
+			values: map[string]tftypes.Value{
+				"json_configuration": tftypes.NewValue(tftypes.String, `{"transforms":[{"type":"drop_field","field":"internal_id"}]}`),
+				"transform_id":       tftypes.NewValue(tftypes.String, "transform-123"),
+			},
+			wantError: true,
+		},
+		{
+			name: "inline transforms in json_configuration and attribute",
+			values: map[string]tftypes.Value{
+				"json_configuration": tftypes.NewValue(tftypes.String, `{"transforms":[{"type":"drop_field","field":"internal_id"}]}`),
+				"transforms":         transformStepList(map[string]string{"type": "drop_field", "field": "internal_id"}),
+			},
+			wantError: true,
+		},
+		{
+			name: "transforms in json_configuration only",
+			values: map[string]tftypes.Value{
+				"json_configuration": tftypes.NewValue(tftypes.String, `{"transforms":[{"type":"drop_field","field":"internal_id"}]}`),
+			},
+			wantError: false,
+		},
+		{
+			name: "transform chain with empty smt_config",
+			values: map[string]tftypes.Value{
+				"json_configuration": tftypes.NewValue(tftypes.String, `{"smt_config":[]}`),
+				"transform_id":       tftypes.NewValue(tftypes.String, "transform-123"),
+			},
+			wantError: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := resource.ValidateConfigRequest{Config: resourceConfig(t, NewPipelineResource(), tt.values)}
+			resp := &resource.ValidateConfigResponse{}
+
+			pipelineConfigurationValidator{}.ValidateResource(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("ValidateResource() diagnostics = %v, wantError %v", resp.Diagnostics, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestPipelineSetConfiguration(t *testing.T) {
+	sourceType := "KAFKA_SOURCE"
+	connectorID := "connector-123"
+	revision := int64(2)
+	field := "email"
+
+	config := &client.PipelineConfiguration{
+		SourceName:              "orders",
+		SourceType:              &sourceType,
+		SourceConfig:            map[string]any{"topic": "orders", "sasl_password": "hunter2"},
+		TargetName:              "warehouse",
+		TargetConfig:            map[string]any{"password": map[string]any{"secret_ref": "secret-123"}},
+		SMTConfig:               []any{},
+		TargetConnectorID:       &connectorID,
+		TargetConnectorRevision: &revision,
+		Transforms:              []client.TransformStep{{Type: "mask", Field: &field}},
+	}
+
+	model := pipelineResourceModel{ID: types.StringValue("pipeline-123")}
+	diags := model.setConfiguration(context.Background(), config)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	want := `{"smt_config":[],` +
+		`"source_config":{"sasl_password":"hunter2","topic":"orders"},"source_name":"orders","source_type":"KAFKA_SOURCE",` +
+		`"target_config":{"password":{"secret_ref":"secret-123"}},"target_name":"warehouse"}`
+	if model.JSONConfiguration.ValueString() != want {
+		t.Errorf("expected json_configuration %s, got %s", want, model.JSONConfiguration.ValueString())
+	}
+
+	if model.TargetConnectorID.ValueString() != connectorID || model.TargetConnectorRevision.ValueInt64() != revision {
+		t.Errorf("expected target connector %s revision %d, got %s revision %d",
+			connectorID, revision, model.TargetConnectorID.ValueString(), model.TargetConnectorRevision.ValueInt64())
+	}
+
+	if !model.SourceConnectorID.IsNull() || !model.TransformID.IsNull() {
+		t.Errorf("expected null source connector and transform references")
+	}
+
+	var transforms []transformStepModel
+	model.Transforms.ElementsAs(context.Background(), &transforms, false)
+	if len(transforms) != 1 || transforms[0].Field.ValueString() != field {
+		t.Errorf("expected one mask transform on %s, got %v", field, model.Transforms)
+	}
+
+	// Only the plaintext password is reported, not the secret reference
+	if diags.WarningsCount() != 1 || !strings.Contains(diags.Warnings()[0].Detail(), "source_config.sasl_password") ||
+		strings.Contains(diags.Warnings()[0].Detail(), "target_config") {
+		t.Errorf("expected a warning about source_config.sasl_password only, got %v", diags)
+	}
+}
+
+func TestPipelineResourceModel_UnknownTransforms(t *testing.T) {
+	ctx := context.Background()
+	config := resourceConfig(t, NewPipelineResource(), map[string]tftypes.Value{
+		"name":               tftypes.NewValue(tftypes.String, "orders"),
+		"json_configuration": tftypes.NewValue(tftypes.String, `{"source_name":"orders"}`),
+		"transforms": tftypes.NewValue(tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+			"type":              tftypes.String,
+			"condition":         tftypes.String,
+			"field":             tftypes.String,
+			"new_name":          tftypes.String,
+			"replacement":       tftypes.String,
+			"to_type":           tftypes.String,
+			"topic_regex":       tftypes.String,
+			"topic_replacement": tftypes.String,
+		}}}, tftypes.UnknownValue),
+	})
+
+	// Transforms computed by another resource are unknown until apply
+	var model pipelineResourceModel
+	if diags := config.Get(ctx, &model); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if !model.Transforms.IsUnknown() {
+		t.Fatalf("expected unknown transforms, got %v", model.Transforms)
+	}
+
+	if _, diags := model.configuration(ctx); diags.HasError() {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+
+	other := model
+	other.Transforms = types.ListNull(types.ObjectType{AttrTypes: transformStepAttrTypes()})
+	if !model.configurationChanged(&other) {
+		t.Errorf("expected unknown transforms to differ from null transforms")
+	}
+}
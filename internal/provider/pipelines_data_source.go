@@ -0,0 +1,307 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ datasource.DataSource              = &pipelinesDataSource{}
+	_ datasource.DataSourceWithConfigure = &pipelinesDataSource{}
+)
+
+// NewPipelinesDataSource creates a new pipelines data source
+func NewPipelinesDataSource() datasource.DataSource {
+	return &pipelinesDataSource{}
+}
+
+// pipelinesDataSource defines the data source implementation
+type pipelinesDataSource struct {
+	client *client.Client
+}
+
+// pipelinesDataSourceModel describes the data source data model
+type pipelinesDataSourceModel struct {
+	NameRegex     types.String                 `tfsdk:"name_regex"`
+	TeamID        types.String                 `tfsdk:"team_id"`
+	EnvID         types.String                 `tfsdk:"env_id"`
+	State         types.String                 `tfsdk:"state"`
+	ConnectorType types.String                 `tfsdk:"connector_type"`
+	Pipelines     []pipelinesDataPipelineModel `tfsdk:"pipelines"`
+}
+
+// pipelinesDataPipelineModel describes a single pipeline of the pipelines list
+type pipelinesDataPipelineModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	TeamID           types.String `tfsdk:"team_id"`
+	TeamName         types.String `tfsdk:"team_name"`
+	State            types.String `tfsdk:"state"`
+	SourceType       types.String `tfsdk:"source_type"`
+	TargetType       types.String `tfsdk:"target_type"`
+	LastError        types.String `tfsdk:"last_error"`
+	RecordsProcessed types.Int64  `tfsdk:"records_processed"`
+	ConsumerLag      types.Int64  `tfsdk:"consumer_lag"`
+}
+
+// Metadata returns the data source type name
+func (d *pipelinesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pipelines"
+}
+
+// Schema defines the data source schema
+func (d *pipelinesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	connectorTypes := slices.Concat(validSourceConnectorTypes, validTargetConnectorTypes)
+
+	resp.Schema = schema.Schema{
+		Description: "Lists the Popsink pipelines of the organization.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Description: "Only return pipelines whose name matches this regular expression.",
+				Optional:    true,
+				Validators: []validator.String{
+					regexpValidator{},
+				},
+			},
+			"team_id": schema.StringAttribute{
+				Description: "Only return pipelines owned by this team.",
+				Optional:    true,
+			},
+			"env_id": schema.StringAttribute{
+				Description: "Only return pipelines owned by the teams of this environment.",
+				Optional:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "Only return pipelines in this state. Valid values: draft, paused, live, error, building.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(validPipelineStates...),
+				},
+			},
+			"connector_type": schema.StringAttribute{
+				Description: fmt.Sprintf("Only return pipelines whose source or target has this connector type, including sources and targets referencing a connector. Valid values: %v.", connectorTypes),
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(connectorTypes...),
+				},
+			},
+			"pipelines": schema.ListNestedAttribute{
+				Description: "The pipelines matching the filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the pipeline.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the pipeline.",
+							Computed:    true,
+						},
+						"team_id": schema.StringAttribute{
+							Description: "The UUID of the team that owns the pipeline.",
+							Computed:    true,
+						},
+						"team_name": schema.StringAttribute{
+							Description: "The name of the team that owns the pipeline.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "The state of the pipeline.",
+							Computed:    true,
+						},
+						"source_type": schema.StringAttribute{
+							Description: "The connector type of the pipeline source, or of the connector it references, if known.",
+							Computed:    true,
+						},
+						"target_type": schema.StringAttribute{
+							Description: "The connector type of the pipeline target, or of the connector it references, if known.",
+							Computed:    true,
+						},
+						"last_error": schema.StringAttribute{
+							Description: "The last error reported by the pipeline runtime, if any.",
+							Computed:    true,
+						},
+						"records_processed": schema.Int64Attribute{
+							Description: "The number of records processed by the pipeline.",
+							Computed:    true,
+						},
+						"consumer_lag": schema.Int64Attribute{
+							Description: "The current consumer lag of the pipeline source, in messages.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source
+func (d *pipelinesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the data source state
+func (d *pipelinesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config pipelinesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameFilter, err := nameRegexp(config.NameRegex)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Regular Expression",
+			fmt.Sprintf("Could not compile name_regex: %s", err.Error()),
+		)
+		return
+	}
+
+	// Pipelines have no environment, so resolve the teams of the environment
+	var envTeams map[string]bool
+	if !config.EnvID.IsNull() {
+		teams, err := d.client.ListTeams(ctx, client.TeamFilter{EnvID: config.EnvID.ValueString()})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Listing Teams",
+				fmt.Sprintf("Could not list the teams of environment %s: %s", config.EnvID.ValueString(), err.Error()),
+			)
+			return
+		}
+		envTeams = envTeamIDs(config.EnvID.ValueString(), teams)
+	}
+
+	pipelines, err := d.client.ListPipelines(ctx, client.PipelineFilter{
+		TeamID: config.TeamID.ValueString(),
+		State:  client.PipelineState(config.State.ValueString()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Pipelines",
+			fmt.Sprintf("Could not list pipelines: %s", err.Error()),
+		)
+		return
+	}
+
+	connectorType := config.ConnectorType.ValueString()
+	connectors := &connectorTypeResolver{client: d.client, types: make(map[string]*string)}
+
+	config.Pipelines = make([]pipelinesDataPipelineModel, 0, len(pipelines))
+	for _, pipeline := range pipelines {
+		if nameFilter != nil && !nameFilter.MatchString(pipeline.Name) {
+			continue
+		}
+
+		if envTeams != nil && !envTeams[pipeline.TeamID] {
+			continue
+		}
+
+		// Pipelines referencing connectors have no inline type, so read it from the connector
+		var sourceType, targetType *string
+		if pipeline.JSONConfiguration != nil {
+			sourceType, err = connectors.connectorType(ctx, client.ConnectorKindSource,
+				pipeline.JSONConfiguration.SourceType, pipeline.JSONConfiguration.SourceConnectorID)
+			if err == nil {
+				targetType, err = connectors.connectorType(ctx, client.ConnectorKindTarget,
+					pipeline.JSONConfiguration.TargetType, pipeline.JSONConfiguration.TargetConnectorID)
+			}
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error Reading Connector",
+					fmt.Sprintf("Could not read a connector of pipeline %s: %s", pipeline.ID, err.Error()),
+				)
+				return
+			}
+		}
+
+		if connectorType != "" && !matchesConnectorType(connectorType, sourceType, targetType) {
+			continue
+		}
+
+		config.Pipelines = append(config.Pipelines, pipelinesDataPipelineModel{
+			ID:               types.StringValue(pipeline.ID),
+			Name:             types.StringValue(pipeline.Name),
+			TeamID:           types.StringValue(pipeline.TeamID),
+			TeamName:         types.StringValue(pipeline.TeamName),
+			State:            types.StringValue(string(pipeline.State)),
+			SourceType:       types.StringPointerValue(sourceType),
+			TargetType:       types.StringPointerValue(targetType),
+			LastError:        types.StringPointerValue(pipeline.LastError),
+			RecordsProcessed: types.Int64PointerValue(pipeline.RecordsProcessed),
+			ConsumerLag:      types.Int64PointerValue(pipeline.ConsumerLag),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// matchesConnectorType reports whether the source or target connector type equals connectorType
+func matchesConnectorType(connectorType string, sourceType, targetType *string) bool {
+	return (sourceType != nil && *sourceType == connectorType) || (targetType != nil && *targetType == connectorType)
+}
+
+// envTeamIDs returns the IDs of the teams that belong to the environment envID
+func envTeamIDs(envID string, teams []client.TeamRead) map[string]bool {
+	ids := make(map[string]bool, len(teams))
+	for _, team := range teams {
+		if team.EnvID != nil && *team.EnvID == envID {
+			ids[team.ID] = true
+		}
+	}
+	return ids
+}
+
+// connectorTypeResolver reads the type of the connectors referenced by pipelines, reading each connector once
+type connectorTypeResolver struct {
+	client *client.Client
+	types  map[string]*string
+}
+
+// connectorType returns the inline connector type of a pipeline side if set, and otherwise the type of
+// the referenced connector. It returns nil when the pipeline side has neither or the connector is gone.
+func (r *connectorTypeResolver) connectorType(ctx context.Context, kind client.ConnectorKind, inlineType, connectorID *string) (*string, error) {
+	if inlineType != nil || connectorID == nil {
+		return inlineType, nil
+	}
+
+	key := string(kind) + "/" + *connectorID
+	if connectorType, ok := r.types[key]; ok {
+		return connectorType, nil
+	}
+
+	connector, err := r.client.GetConnector(ctx, kind, *connectorID)
+	if err != nil {
+		return nil, err
+	}
+
+	var connectorType *string
+	if connector != nil {
+		connectorType = &connector.Type
+	}
+	r.types[key] = connectorType
+	return connectorType, nil
+}
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+func TestMatchesConnectorType(t *testing.T) {
+	source := "KAFKA_SOURCE"
+	target := "ORACLE_TARGET"
+
+	if !matchesConnectorType("KAFKA_SOURCE", &source, &target) {
+		t.Errorf("expected the source type to match")
+	}
+	if !matchesConnectorType("ORACLE_TARGET", &source, &target) {
+		t.Errorf("expected the target type to match")
+	}
+	if matchesConnectorType("ORACLE_TARGET", &source, nil) {
+		t.Errorf("expected no match without a target type")
+	}
+}
+
+func TestEnvTeamIDs(t *testing.T) {
+	production := "env-prod"
+	staging := "env-staging"
+
+	ids := envTeamIDs("env-prod", []client.TeamRead{
+		{ID: "team-1", EnvID: &production},
+		{ID: "team-2", EnvID: &staging},
+		{ID: "team-3"},
+	})
+
+	if len(ids) != 1 || !ids["team-1"] {
+		t.Errorf("expected only team-1, got %v", ids)
+	}
+}
+
+// readPipelinesDataSource reads the pipelines data source with the given filters against a test server
+func readPipelinesDataSource(t *testing.T, serverURL string, config pipelinesDataSourceModel) pipelinesDataSourceModel {
+	t.Helper()
+
+	ctx := context.Background()
+	d := &pipelinesDataSource{client: client.NewClient(serverURL, "test-token")}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	raw := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	if diags := raw.Set(ctx, &config); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: raw.Raw}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: raw.Raw}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var got pipelinesDataSourceModel
+	if diags := resp.State.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	return got
+}
+
+// pipelinesFilters returns the filters of the pipelines data source, all unset
+func pipelinesFilters() pipelinesDataSourceModel {
+	return pipelinesDataSourceModel{
+		NameRegex:     types.StringNull(),
+		TeamID:        types.StringNull(),
+		EnvID:         types.StringNull(),
+		State:         types.StringNull(),
+		ConnectorType: types.StringNull(),
+	}
+}
+
+func TestPipelinesDataSource_EnvID(t *testing.T) {
+	production := "env-prod"
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/teams/":
+			if r.URL.Query().Get("env_id") != "env-prod" {
+				t.Errorf("expected env_id env-prod, got %s", r.URL.Query().Get("env_id"))
+			}
+			_ = json.NewEncoder(w).Encode(client.Page[client.TeamRead]{
+				Items: []client.TeamRead{{ID: "team-1", Name: "data", EnvID: &production}},
+				Total: 1, Page: 1, Size: 100, Pages: 1,
+			})
+		case "/pipelines/":
+			_ = json.NewEncoder(w).Encode(client.Page[client.PipelineRead]{
+				Items: []client.PipelineRead{
+					{ID: "pipeline-1", Name: "orders", State: "live", TeamID: "team-1", TeamName: "data"},
+					{ID: "pipeline-2", Name: "payments", State: "live", TeamID: "team-2", TeamName: "billing"},
+				},
+				Total: 2, Page: 1, Size: 100, Pages: 1,
+			})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer httpServer.Close()
+
+	filters := pipelinesFilters()
+	filters.EnvID = types.StringValue("env-prod")
+	got := readPipelinesDataSource(t, httpServer.URL, filters)
+
+	if len(got.Pipelines) != 1 || got.Pipelines[0].ID.ValueString() != "pipeline-1" {
+		t.Errorf("expected only pipeline-1, got %v", got.Pipelines)
+	}
+}
+
+func TestPipelinesDataSource_ConnectorReferences(t *testing.T) {
+	kafkaSource := "KAFKA_SOURCE"
+	connectorID := "connector-123"
+	var connectorRequests int
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/pipelines/":
+			_ = json.NewEncoder(w).Encode(client.Page[client.PipelineRead]{
+				Items: []client.PipelineRead{
+					{ID: "pipeline-1", Name: "orders", State: "live", JSONConfiguration: &client.PipelineConfiguration{TargetConnectorID: &connectorID}},
+					{ID: "pipeline-2", Name: "refunds", State: "live", JSONConfiguration: &client.PipelineConfiguration{TargetConnectorID: &connectorID}},
+					{ID: "pipeline-3", Name: "events", State: "live", JSONConfiguration: &client.PipelineConfiguration{SourceType: &kafkaSource}},
+				},
+				Total: 3, Page: 1, Size: 100, Pages: 1,
+			})
+		case "/target-connectors/connector-123":
+			connectorRequests++
+			_ = json.NewEncoder(w).Encode(client.ConnectorRead{ID: connectorID, Name: "warehouse", Type: "ORACLE_TARGET"})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer httpServer.Close()
+
+	filters := pipelinesFilters()
+	filters.ConnectorType = types.StringValue("ORACLE_TARGET")
+	got := readPipelinesDataSource(t, httpServer.URL, filters)
+
+	if len(got.Pipelines) != 2 || got.Pipelines[0].ID.ValueString() != "pipeline-1" || got.Pipelines[1].ID.ValueString() != "pipeline-2" {
+		t.Fatalf("expected pipeline-1 and pipeline-2, got %v", got.Pipelines)
+	}
+
+	if got.Pipelines[0].TargetType.ValueString() != "ORACLE_TARGET" || !got.Pipelines[0].SourceType.IsNull() {
+		t.Errorf("expected target_type ORACLE_TARGET and null source_type, got %s and %s", got.Pipelines[0].TargetType, got.Pipelines[0].SourceType)
+	}
+
+	if connectorRequests != 1 {
+		t.Errorf("expected the connector to be read once, got %d requests", connectorRequests)
+	}
+}
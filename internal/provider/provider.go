@@ -5,6 +5,9 @@ import (
 	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -14,8 +17,13 @@ import (
 	"github.com/popsink/terraform-provider-popsink/internal/client"
 )
 
-// Ensure the implementation satisfies the provider.Provider interface
-var _ provider.Provider = &popsinkProvider{}
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ provider.Provider                       = &popsinkProvider{}
+	_ provider.ProviderWithEphemeralResources = &popsinkProvider{}
+	_ provider.ProviderWithFunctions          = &popsinkProvider{}
+	_ provider.ProviderWithListResources      = &popsinkProvider{}
+)
 
 // popsinkProvider defines the provider implementation
 type popsinkProvider struct {
@@ -115,6 +123,8 @@ func (p *popsinkProvider) Configure(ctx context.Context, req provider.ConfigureR
 	// Make the client available to resources and data sources
 	resp.DataSourceData = c
 	resp.ResourceData = c
+	resp.EphemeralResourceData = c
+	resp.ListResourceData = c
 
 	tflog.Info(ctx, "Configured Popsink client", map[string]any{"base_url": baseURL})
 }
@@ -125,12 +135,59 @@ func (p *popsinkProvider) Resources(ctx context.Context) []func() resource.Resou
 		NewPipelineResource,
 		NewTeamResource,
 		NewEnvResource,
+		NewTeamMemberResource,
+		NewTeamMembersResource,
+		NewUserResource,
+		NewRoleResource,
+		NewRoleBindingResource,
+		NewServiceAccountResource,
+		NewAPITokenResource,
+		NewSourceConnectorResource,
+		NewTargetConnectorResource,
+		NewTransformResource,
+		NewSecretResource,
+		NewNotificationChannelResource,
+		NewAlertRuleResource,
 	}
 }
 
 // DataSources returns the provider's data sources
 func (p *popsinkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
-		// No data sources yet
+		NewUsersDataSource,
+		NewEnvDataSource,
+		NewTeamDataSource,
+		NewPipelineDataSource,
+		NewEnvsDataSource,
+		NewTeamsDataSource,
+		NewPipelinesDataSource,
+		NewCurrentIdentityDataSource,
+	}
+}
+
+// EphemeralResources returns the provider's ephemeral resources
+func (p *popsinkProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewAccessTokenEphemeralResource,
+	}
+}
+
+// Functions returns the provider's functions
+func (p *popsinkProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewKafkaSourceFunction,
+		NewOracleTargetFunction,
+		NewPipelineConfigurationFunction,
+		NewNormalizeConfigFunction,
+		NewConfigDiffFunction,
+	}
+}
+
+// ListResources returns the provider's list resources
+func (p *popsinkProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewEnvListResource,
+		NewTeamListResource,
+		NewPipelineListResource,
 	}
 }
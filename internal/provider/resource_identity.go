@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+// resourceIdentityModel describes the identity of a resource: its UUID and the organization it belongs to
+type resourceIdentityModel struct {
+	ID    types.String `tfsdk:"id"`
+	OrgID types.String `tfsdk:"org_id"`
+}
+
+// resourceIdentitySchema returns the identity schema of a resource identified by its UUID
+func resourceIdentitySchema(object string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       fmt.Sprintf("The unique identifier of the %s.", object),
+				RequiredForImport: true,
+			},
+			"org_id": identityschema.StringAttribute{
+				Description: fmt.Sprintf("The unique identifier of the organization of the %s. "+
+					"When set on import, it must be the organization of the configured API token.", object),
+				OptionalForImport: true,
+			},
+		},
+	}
+}
+
+// setResourceIdentity sets the identity of the resource with the given ID in the organization of the API token.
+// The organization is best-effort: when it cannot be read, the previous one is kept, or org_id is left null.
+func setResourceIdentity(ctx context.Context, c *client.Client, identity *tfsdk.ResourceIdentity, id types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	orgID := types.StringNull()
+	if !identity.Raw.IsNull() {
+		diags.Append(identity.GetAttribute(ctx, path.Root("org_id"), &orgID)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	currentOrgID, err := c.OrganizationID(ctx)
+	if err != nil {
+		tflog.Warn(ctx, "Could not read the organization of the API token, keeping the previous org_id", map[string]any{
+			"id":    id.ValueString(),
+			"error": err.Error(),
+		})
+	} else {
+		orgID = types.StringValue(currentOrgID)
+	}
+
+	diags.Append(identity.Set(ctx, resourceIdentityModel{ID: id, OrgID: orgID})...)
+	return diags
+}
+
+// importStateFromIdentity imports a resource from the identity given in an import block, refusing
+// identities of another organization than the one of the API token
+func importStateFromIdentity(ctx context.Context, c *client.Client, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity resourceIdentityModel
+	resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !identity.OrgID.IsNull() {
+		orgID, err := c.OrganizationID(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Organization",
+				fmt.Sprintf("Could not read the organization of the API token: %s", err.Error()),
+			)
+			return
+		}
+
+		if identity.OrgID.ValueString() != orgID {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("org_id"),
+				"Organization Mismatch",
+				fmt.Sprintf("The identity belongs to organization %s, but the API token belongs to organization %s.",
+					identity.OrgID.ValueString(), orgID),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.ID)...)
+}
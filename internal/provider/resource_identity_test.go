@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+func TestEnvResource_ImportStateFromIdentity(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/me" {
+			t.Errorf("expected path /me, got %s", r.URL.Path)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(client.IdentityRead{OrganizationID: "org-123"})
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name      string
+		orgID     any
+		wantError bool
+	}{
+		{name: "without organization", orgID: nil, wantError: false},
+		{name: "same organization", orgID: "org-123", wantError: false},
+		{name: "other organization", orgID: "org-456", wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &envResource{client: client.NewClient(server.URL, "test-token")}
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			identityResp := &resource.IdentitySchemaResponse{}
+			r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, identityResp)
+
+			identityType := identityResp.IdentitySchema.Type().TerraformType(ctx)
+			identity := &tfsdk.ResourceIdentity{
+				Schema: identityResp.IdentitySchema,
+				Raw: tftypes.NewValue(identityType, map[string]tftypes.Value{
+					"id":     tftypes.NewValue(tftypes.String, "env-123"),
+					"org_id": tftypes.NewValue(tftypes.String, tt.orgID),
+				}),
+			}
+
+			req := resource.ImportStateRequest{Identity: identity}
+			resp := &resource.ImportStateResponse{
+				State: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+				},
+				Identity: identity,
+			}
+			r.ImportState(ctx, req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Fatalf("ImportState() diagnostics = %v, wantError %v", resp.Diagnostics, tt.wantError)
+			}
+			if tt.wantError {
+				return
+			}
+
+			var id types.String
+			resp.State.GetAttribute(ctx, path.Root("id"), &id)
+			if id.ValueString() != "env-123" {
+				t.Errorf("expected id env-123, got %s", id.ValueString())
+			}
+		})
+	}
+}
+
+func TestSetResourceIdentity(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		priorOrgID any
+		wantOrgID  types.String
+	}{
+		{name: "organization read", status: http.StatusOK, priorOrgID: nil, wantOrgID: types.StringValue("org-123")},
+		{name: "organization unavailable", status: http.StatusForbidden, priorOrgID: nil, wantOrgID: types.StringNull()},
+		{name: "organization unavailable with prior identity", status: http.StatusForbidden, priorOrgID: "org-456", wantOrgID: types.StringValue("org-456")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_ = json.NewEncoder(w).Encode(client.IdentityRead{OrganizationID: "org-123"})
+			}))
+			defer server.Close()
+
+			ctx := context.Background()
+			r := &envResource{}
+			identityResp := &resource.IdentitySchemaResponse{}
+			r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, identityResp)
+
+			identityType := identityResp.IdentitySchema.Type().TerraformType(ctx)
+			identity := &tfsdk.ResourceIdentity{
+				Schema: identityResp.IdentitySchema,
+				Raw:    tftypes.NewValue(identityType, nil),
+			}
+			if tt.priorOrgID != nil {
+				identity.Raw = tftypes.NewValue(identityType, map[string]tftypes.Value{
+					"id":     tftypes.NewValue(tftypes.String, "env-123"),
+					"org_id": tftypes.NewValue(tftypes.String, tt.priorOrgID),
+				})
+			}
+
+			diags := setResourceIdentity(ctx, client.NewClient(server.URL, "test-token"), identity, types.StringValue("env-123"))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			var got resourceIdentityModel
+			identity.Get(ctx, &got)
+			if got.ID.ValueString() != "env-123" || !got.OrgID.Equal(tt.wantOrgID) {
+				t.Errorf("expected identity env-123 in %s, got %s in %s", tt.wantOrgID, got.ID, got.OrgID)
+			}
+		})
+	}
+}
@@ -0,0 +1,244 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+// Valid role binding principal types
+var validPrincipalTypes = []string{
+	"user",
+	"team",
+	"service_account",
+}
+
+// Valid role binding scope types
+var validScopeTypes = []string{
+	"env",
+	"team",
+	"pipeline",
+}
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ resource.Resource                = &roleBindingResource{}
+	_ resource.ResourceWithConfigure   = &roleBindingResource{}
+	_ resource.ResourceWithImportState = &roleBindingResource{}
+)
+
+// NewRoleBindingResource creates a new role binding resource
+func NewRoleBindingResource() resource.Resource {
+	return &roleBindingResource{}
+}
+
+// roleBindingResource defines the resource implementation
+type roleBindingResource struct {
+	client *client.Client
+}
+
+// roleBindingResourceModel describes the resource data model
+type roleBindingResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	RoleID        types.String `tfsdk:"role_id"`
+	PrincipalType types.String `tfsdk:"principal_type"`
+	PrincipalID   types.String `tfsdk:"principal_id"`
+	ScopeType     types.String `tfsdk:"scope_type"`
+	ScopeID       types.String `tfsdk:"scope_id"`
+}
+
+// Metadata returns the resource type name
+func (r *roleBindingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_binding"
+}
+
+// Schema defines the resource schema
+func (r *roleBindingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Grants a Popsink role to a principal on an environment, team or pipeline. " +
+			"Role bindings cannot be updated in place; any change creates a new binding.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the role binding.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				Description: "The UUID of the role to grant.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_type": schema.StringAttribute{
+				Description: "The type of the principal the role is granted to. Valid values: user, team, service_account.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(validPrincipalTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_id": schema.StringAttribute{
+				Description: "The UUID of the principal the role is granted to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scope_type": schema.StringAttribute{
+				Description: "The type of the object the role applies to. Valid values: env, team, pipeline.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(validScopeTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scope_id": schema.StringAttribute{
+				Description: "The UUID of the environment, team or pipeline the role applies to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource
+func (r *roleBindingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource
+func (r *roleBindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan roleBindingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create role binding
+	createReq := &client.RoleBindingCreate{
+		RoleID:        plan.RoleID.ValueString(),
+		PrincipalType: plan.PrincipalType.ValueString(),
+		PrincipalID:   plan.PrincipalID.ValueString(),
+		ScopeType:     plan.ScopeType.ValueString(),
+		ScopeID:       plan.ScopeID.ValueString(),
+	}
+
+	binding, err := r.client.CreateRoleBinding(ctx, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Role Binding",
+			fmt.Sprintf("Could not create role binding: %s", err.Error()),
+		)
+		return
+	}
+
+	// Update state with created role binding
+	plan.ID = types.StringValue(binding.ID)
+	plan.RoleID = types.StringValue(binding.RoleID)
+	plan.PrincipalType = types.StringValue(binding.PrincipalType)
+	plan.PrincipalID = types.StringValue(binding.PrincipalID)
+	plan.ScopeType = types.StringValue(binding.ScopeType)
+	plan.ScopeID = types.StringValue(binding.ScopeID)
+
+	tflog.Info(ctx, "Created role binding", map[string]any{"id": binding.ID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the resource state
+func (r *roleBindingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state roleBindingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	binding, err := r.client.GetRoleBinding(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Role Binding",
+			fmt.Sprintf("Could not read role binding %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// If role binding not found, remove from state
+	if binding == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Update state
+	state.RoleID = types.StringValue(binding.RoleID)
+	state.PrincipalType = types.StringValue(binding.PrincipalType)
+	state.PrincipalID = types.StringValue(binding.PrincipalID)
+	state.ScopeType = types.StringValue(binding.ScopeType)
+	state.ScopeID = types.StringValue(binding.ScopeID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called because every attribute requires replacement
+func (r *roleBindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Role Binding Update Not Supported",
+		"Role bindings cannot be updated in place. Please report this issue to the provider developers.",
+	)
+}
+
+// Delete deletes the resource
+func (r *roleBindingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state roleBindingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteRoleBinding(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Role Binding",
+			fmt.Sprintf("Could not delete role binding %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Deleted role binding", map[string]any{"id": state.ID.ValueString()})
+}
+
+// ImportState imports the resource state
+func (r *roleBindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,311 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ resource.Resource                = &roleResource{}
+	_ resource.ResourceWithConfigure   = &roleResource{}
+	_ resource.ResourceWithModifyPlan  = &roleResource{}
+	_ resource.ResourceWithImportState = &roleResource{}
+)
+
+// NewRoleResource creates a new role resource
+func NewRoleResource() resource.Resource {
+	return &roleResource{}
+}
+
+// roleResource defines the resource implementation
+type roleResource struct {
+	client *client.Client
+}
+
+// roleResourceModel describes the resource data model
+type roleResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Permissions types.Set    `tfsdk:"permissions"`
+}
+
+// Metadata returns the resource type name
+func (r *roleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+// Schema defines the resource schema
+func (r *roleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a custom Popsink role, a named set of permissions that can be bound to principals.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the role.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the role.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Short description of the role.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"permissions": schema.SetAttribute{
+				Description: "The permissions granted by the role. Each permission must exist in the Popsink permission catalog.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource
+func (r *roleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan validates the planned permissions against the permission catalog
+func (r *roleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy, or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var permissions types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("permissions"), &permissions)...)
+	if resp.Diagnostics.HasError() || permissions.IsNull() || permissions.IsUnknown() {
+		return
+	}
+
+	var planned []types.String
+	resp.Diagnostics.Append(permissions.ElementsAs(ctx, &planned, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	catalog, err := r.client.ListPermissions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Permission Catalog",
+			fmt.Sprintf("Could not list permissions: %s", err.Error()),
+		)
+		return
+	}
+
+	known := make(map[string]bool, len(catalog))
+	names := make([]string, 0, len(catalog))
+	for _, permission := range catalog {
+		known[permission.Name] = true
+		names = append(names, permission.Name)
+	}
+	sort.Strings(names)
+
+	for _, permission := range planned {
+		if permission.IsUnknown() || known[permission.ValueString()] {
+			continue
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			path.Root("permissions"),
+			"Unknown Permission",
+			fmt.Sprintf("Permission %q does not exist. Valid permissions: %v", permission.ValueString(), names),
+		)
+	}
+}
+
+// Create creates the resource
+func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan roleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var permissions []string
+	resp.Diagnostics.Append(plan.Permissions.ElementsAs(ctx, &permissions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create role
+	createReq := &client.RoleCreate{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		Permissions: permissions,
+	}
+
+	role, err := r.client.CreateRole(ctx, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Role",
+			fmt.Sprintf("Could not create role: %s", err.Error()),
+		)
+		return
+	}
+
+	// Update state with created role
+	plan.ID = types.StringValue(role.ID)
+	plan.Name = types.StringValue(role.Name)
+	plan.Description = types.StringValue(role.Description)
+	permissionsValue, diags := types.SetValueFrom(ctx, types.StringType, append([]string{}, role.Permissions...))
+	resp.Diagnostics.Append(diags...)
+	plan.Permissions = permissionsValue
+
+	tflog.Info(ctx, "Created role", map[string]any{"id": role.ID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the resource state
+func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state roleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, err := r.client.GetRole(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Role",
+			fmt.Sprintf("Could not read role %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// If role not found, remove from state
+	if role == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Update state
+	state.Name = types.StringValue(role.Name)
+	state.Description = types.StringValue(role.Description)
+	permissionsValue, diags := types.SetValueFrom(ctx, types.StringType, append([]string{}, role.Permissions...))
+	resp.Diagnostics.Append(diags...)
+	state.Permissions = permissionsValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource
+func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan roleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state roleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build update request
+	updateReq := &client.RoleUpdate{}
+
+	if !plan.Name.Equal(state.Name) {
+		name := plan.Name.ValueString()
+		updateReq.Name = &name
+	}
+
+	if !plan.Description.Equal(state.Description) {
+		description := plan.Description.ValueString()
+		updateReq.Description = &description
+	}
+
+	if !plan.Permissions.Equal(state.Permissions) {
+		var permissions []string
+		resp.Diagnostics.Append(plan.Permissions.ElementsAs(ctx, &permissions, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		updateReq.Permissions = &permissions
+	}
+
+	// Update role
+	role, err := r.client.UpdateRole(ctx, state.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Role",
+			fmt.Sprintf("Could not update role %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// Update state
+	plan.ID = types.StringValue(role.ID)
+	plan.Name = types.StringValue(role.Name)
+	plan.Description = types.StringValue(role.Description)
+	permissionsValue, diags := types.SetValueFrom(ctx, types.StringType, append([]string{}, role.Permissions...))
+	resp.Diagnostics.Append(diags...)
+	plan.Permissions = permissionsValue
+
+	tflog.Info(ctx, "Updated role", map[string]any{"id": role.ID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource
+func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state roleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteRole(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Role",
+			fmt.Sprintf("Could not delete role %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Deleted role", map[string]any{"id": state.ID.ValueString()})
+}
+
+// ImportState imports the resource state
+func (r *roleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
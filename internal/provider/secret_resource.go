@@ -0,0 +1,292 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ resource.Resource                     = &secretResource{}
+	_ resource.ResourceWithConfigure        = &secretResource{}
+	_ resource.ResourceWithConfigValidators = &secretResource{}
+	_ resource.ResourceWithImportState      = &secretResource{}
+)
+
+// NewSecretResource creates a new secret resource
+func NewSecretResource() resource.Resource {
+	return &secretResource{}
+}
+
+// secretResource defines the resource implementation
+type secretResource struct {
+	client *client.Client
+}
+
+// secretResourceModel describes the resource data model
+type secretResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	EnvID        types.String `tfsdk:"env_id"`
+	TeamID       types.String `tfsdk:"team_id"`
+	Value        types.String `tfsdk:"value"`
+	ValueVersion types.Int64  `tfsdk:"value_version"`
+	Version      types.Int64  `tfsdk:"version"`
+	UpdatedAt    types.String `tfsdk:"updated_at"`
+}
+
+// setSecret copies the secret returned by the API into the model
+func (m *secretResourceModel) setSecret(secret *client.SecretRead) {
+	m.ID = types.StringValue(secret.ID)
+	m.Name = types.StringValue(secret.Name)
+	m.EnvID = types.StringPointerValue(secret.EnvID)
+	m.TeamID = types.StringPointerValue(secret.TeamID)
+	m.Version = types.Int64Value(secret.Version)
+	m.UpdatedAt = types.StringValue(secret.UpdatedAt)
+}
+
+// Metadata returns the resource type name
+func (r *secretResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret"
+}
+
+// Schema defines the resource schema
+func (r *secretResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Popsink secret, a credential stored by Popsink and referenced from connector, " +
+			"pipeline and retention configurations with {\"secret_ref\": \"<id>\"}. The secret value is never stored in the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the secret, used in secret_ref references.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the secret.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"env_id": schema.StringAttribute{
+				Description: "The UUID of the environment the secret can be used in.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				Description: "The UUID of the team the secret can be used by.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Description: "The secret value. Write-only: it is sent to Popsink but never stored in the plan or the state. " +
+					"Increment value_version to update it.",
+				Required:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"value_version": schema.Int64Attribute{
+				Description: "Arbitrary version of the secret value. Changing it sends the current value to Popsink.",
+				Optional:    true,
+			},
+			"version": schema.Int64Attribute{
+				Description: "The version of the secret value in Popsink, incremented by every value change.",
+				Computed:    true,
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The RFC 3339 timestamp at which the secret was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource
+func (r *secretResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ConfigValidators returns the resource-level configuration validators
+func (r *secretResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("env_id"),
+			path.MatchRoot("team_id"),
+		),
+	}
+}
+
+// Create creates the resource
+func (r *secretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan secretResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only values are only available in the configuration
+	var value types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("value"), &value)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create secret
+	createReq := &client.SecretCreate{
+		Name:   plan.Name.ValueString(),
+		Value:  value.ValueString(),
+		EnvID:  plan.EnvID.ValueStringPointer(),
+		TeamID: plan.TeamID.ValueStringPointer(),
+	}
+
+	secret, err := r.client.CreateSecret(ctx, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Secret",
+			fmt.Sprintf("Could not create secret: %s", err.Error()),
+		)
+		return
+	}
+
+	// Update state with created secret
+	plan.setSecret(secret)
+
+	tflog.Info(ctx, "Created secret", map[string]any{"id": secret.ID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the resource state
+func (r *secretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state secretResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret, err := r.client.GetSecret(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Secret",
+			fmt.Sprintf("Could not read secret %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// If secret not found, remove from state
+	if secret == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Update state
+	state.setSecret(secret)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource
+func (r *secretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan secretResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state secretResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build update request
+	updateReq := &client.SecretUpdate{}
+
+	if !plan.Name.Equal(state.Name) {
+		name := plan.Name.ValueString()
+		updateReq.Name = &name
+	}
+
+	// The value is only sent when its version changes since write-only values cannot be compared
+	if !plan.ValueVersion.Equal(state.ValueVersion) {
+		var value types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("value"), &value)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		updateReq.Value = value.ValueStringPointer()
+	}
+
+	// Update secret
+	secret, err := r.client.UpdateSecret(ctx, state.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Secret",
+			fmt.Sprintf("Could not update secret %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// Update state
+	plan.setSecret(secret)
+
+	tflog.Info(ctx, "Updated secret", map[string]any{"id": secret.ID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource
+func (r *secretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state secretResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSecret(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Secret",
+			fmt.Sprintf("Could not delete secret %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Deleted secret", map[string]any{"id": state.ID.ValueString()})
+}
+
+// ImportState imports the resource state
+func (r *secretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
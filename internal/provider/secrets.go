@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+// secretRef returns the ID of the secret referenced by a decoded JSON configuration value
+func secretRef(value any) (string, bool) {
+	object, ok := value.(map[string]any)
+	if !ok || len(object) != 1 {
+		return "", false
+	}
+
+	secretID, ok := object[client.SecretRefKey].(string)
+	return secretID, ok && secretID != ""
+}
+
+// secretRefValue returns the configuration value referencing a secret
+func secretRefValue(secretID string) map[string]any {
+	return map[string]any{client.SecretRefKey: secretID}
+}
+
+// maskedValue replaces sensitive values returned by data sources
+const maskedValue = "********"
+
+// Fragments of configuration keys whose values are masked by data sources
+var sensitiveKeyFragments = []string{
+	"password",
+	"secret",
+	"token",
+	"private_key",
+	"api_key",
+}
+
+// isSensitiveKey reports whether the values of a configuration key are masked by data sources
+func isSensitiveKey(key string) bool {
+	lowerKey := strings.ToLower(key)
+	for _, fragment := range sensitiveKeyFragments {
+		if strings.Contains(lowerKey, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// maskSecrets returns a copy of the configuration where the values of sensitive
+// keys are replaced by maskedValue. Secret references are kept since they only
+// hold the ID of the secret.
+func maskSecrets(config map[string]any) map[string]any {
+	masked := make(map[string]any, len(config))
+	for key, value := range config {
+		masked[key] = maskSecretValue(value, isSensitiveKey(key))
+	}
+
+	return masked
+}
+
+// maskSecretValue masks a configuration value, recursing into objects and arrays.
+// Other values are masked when they belong to a sensitive key.
+func maskSecretValue(value any, sensitive bool) any {
+	if _, ok := secretRef(value); ok {
+		return value
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		return maskSecrets(v)
+	case []any:
+		masked := make([]any, len(v))
+		for i, element := range v {
+			masked[i] = maskSecretValue(element, sensitive)
+		}
+		return masked
+	}
+
+	if sensitive {
+		return maskedValue
+	}
+	return value
+}
+
+// plaintextSecretPaths returns the sorted paths of the values maskSecrets would mask,
+// in the format of config_diff, e.g. smt_config[0].password
+func plaintextSecretPaths(config map[string]any, prefix string) []string {
+	var paths []string
+	for key, value := range config {
+		paths = append(paths, plaintextSecretValuePaths(value, isSensitiveKey(key), prefix+key)...)
+	}
+
+	slices.Sort(paths)
+	return paths
+}
+
+// plaintextSecretValuePaths returns the paths of the values maskSecretValue would mask
+func plaintextSecretValuePaths(value any, sensitive bool, valuePath string) []string {
+	if _, ok := secretRef(value); ok {
+		return nil
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		return plaintextSecretPaths(v, valuePath+".")
+	case []any:
+		var paths []string
+		for i, element := range v {
+			paths = append(paths, plaintextSecretValuePaths(element, sensitive, fmt.Sprintf("%s[%d]", valuePath, i))...)
+		}
+		return paths
+	}
+
+	if sensitive {
+		return []string{valuePath}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestMaskSecrets(t *testing.T) {
+	config := map[string]any{
+		"bootstrap_server":  "kafka.example.com:9092",
+		"security_protocol": "SASL_SSL",
+		"sasl_username":     "kafka_user",
+		"sasl_password":     "hunter2",
+		"ssl": map[string]any{
+			"key_password": "changeit",
+		},
+		"api_key": map[string]any{"secret_ref": "secret-123"},
+		"smt_config": []any{
+			map[string]any{"api_key": "leak", "field": "email"},
+			map[string]any{"token": map[string]any{"secret_ref": "secret-456"}},
+		},
+		"tokens": []any{"first", "second"},
+	}
+
+	want := map[string]any{
+		"bootstrap_server":  "kafka.example.com:9092",
+		"security_protocol": "SASL_SSL",
+		"sasl_username":     "kafka_user",
+		"sasl_password":     maskedValue,
+		"ssl": map[string]any{
+			"key_password": maskedValue,
+		},
+		"api_key": map[string]any{"secret_ref": "secret-123"},
+		"smt_config": []any{
+			map[string]any{"api_key": maskedValue, "field": "email"},
+			map[string]any{"token": map[string]any{"secret_ref": "secret-456"}},
+		},
+		"tokens": []any{maskedValue, maskedValue},
+	}
+
+	if got := maskSecrets(config); !reflect.DeepEqual(got, want) {
+		t.Errorf("maskSecrets() = %v, want %v", got, want)
+	}
+
+	if config["sasl_password"] != "hunter2" || config["smt_config"].([]any)[0].(map[string]any)["api_key"] != "leak" {
+		t.Errorf("maskSecrets() modified its input")
+	}
+}
+
+func TestPlaintextSecretPaths(t *testing.T) {
+	config := map[string]any{
+		"topic":         "orders",
+		"sasl_password": "hunter2",
+		"ssl":           map[string]any{"key_password": "changeit"},
+		"api_key":       map[string]any{"secret_ref": "secret-123"},
+		"smt_config": []any{
+			map[string]any{"type": "mask_field"},
+			map[string]any{"password": "leak", "token": map[string]any{"secret_ref": "secret-456"}},
+		},
+		"tokens": []any{"first"},
+	}
+
+	want := []string{
+		"source_config.sasl_password",
+		"source_config.smt_config[1].password",
+		"source_config.ssl.key_password",
+		"source_config.tokens[0]",
+	}
+	if got := plaintextSecretPaths(config, "source_config."); !slices.Equal(got, want) {
+		t.Errorf("plaintextSecretPaths() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// movableSource reports whether a move request comes from a resource of the given type, such as the
+// same resource of a fork of this provider, at a schema version whose state decodes into the current
+// schema. Moves between different resource types are not supported and are reported as errors.
+func movableSource(req resource.MoveStateRequest, resp *resource.MoveStateResponse, typeName string, version int64) bool {
+	if req.SourceTypeName != typeName {
+		resp.Diagnostics.AddError(
+			"Unsupported Source Resource Type",
+			fmt.Sprintf("Cannot move %s from %s to %s: only %s resources, such as those of a fork of this provider, can be moved to %s.",
+				req.SourceTypeName, req.SourceProviderAddress, typeName, typeName, typeName),
+		)
+		return false
+	}
+
+	if req.SourceSchemaVersion > version {
+		resp.Diagnostics.AddError(
+			"Unsupported Source Schema Version",
+			fmt.Sprintf("Cannot move %s from %s at schema version %d: this provider supports schema versions up to %d.",
+				req.SourceTypeName, req.SourceProviderAddress, req.SourceSchemaVersion, version),
+		)
+		return false
+	}
+
+	if req.SourceState == nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Source State",
+			fmt.Sprintf("The state of %s from %s does not match the schema of %s.", req.SourceTypeName, req.SourceProviderAddress, typeName),
+		)
+		return false
+	}
+
+	return true
+}
+
+// moveStateV0 returns a state mover for version 0 states of typeName, decoded into P with the
+// frozen version 0 schema and converted with upgrade. States of later versions are left to the
+// other state movers of the resource.
+func moveStateV0[P, T any](typeName string, upgrade func(P) T) func(context.Context, resource.MoveStateRequest, *resource.MoveStateResponse) {
+	return func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+		if req.SourceSchemaVersion != 0 || !movableSource(req, resp, typeName, 0) {
+			return
+		}
+
+		var prior P
+		resp.Diagnostics.Append(req.SourceState.Get(ctx, &prior)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state := upgrade(prior)
+		resp.Diagnostics.Append(resp.TargetState.Set(ctx, &state)...)
+	}
+}
+
+// moveStateAsIs returns a state mover for states of typeName up to version, decoded with the
+// current schema into the current model T
+func moveStateAsIs[T any](typeName string, version int64) func(context.Context, resource.MoveStateRequest, *resource.MoveStateResponse) {
+	return func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+		if !movableSource(req, resp, typeName, version) {
+			return
+		}
+
+		var state T
+		resp.Diagnostics.Append(req.SourceState.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.TargetState.Set(ctx, &state)...)
+	}
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// moveState moves a raw JSON state of the given source resource into the target resource type through the provider server
+func moveState(t *testing.T, target resource.Resource, targetTypeName, sourceTypeName string, sourceVersion int64, rawState string) (*tfsdk.State, []*tfprotov6.Diagnostic) {
+	t.Helper()
+
+	ctx := context.Background()
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	resp, err := server.MoveResourceState(ctx, &tfprotov6.MoveResourceStateRequest{
+		SourceProviderAddress: "registry.terraform.io/community/popsink",
+		SourceTypeName:        sourceTypeName,
+		SourceSchemaVersion:   sourceVersion,
+		SourceState:           &tfprotov6.RawState{JSON: []byte(rawState)},
+		TargetTypeName:        targetTypeName,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.TargetState == nil {
+		return nil, resp.Diagnostics
+	}
+
+	schemaResp := &resource.SchemaResponse{}
+	target.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	raw, err := resp.TargetState.Unmarshal(schemaResp.Schema.Type().TerraformType(ctx))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return &tfsdk.State{Schema: schemaResp.Schema, Raw: raw}, resp.Diagnostics
+}
+
+func TestEnvResource_MoveState(t *testing.T) {
+	rawState := `{"id":"env-123","name":"production","use_retention":true,"unknown_attribute":"ignored",` +
+		`"retention_configuration":"{\"bootstrap_server\":\"kafka:9092\"}"}`
+
+	tests := []struct {
+		name          string
+		sourceType    string
+		sourceVersion int64
+		wantMoved     bool
+		wantSummary   string
+	}{
+		{name: "same type at version 0", sourceType: "popsink_env", sourceVersion: 0, wantMoved: true},
+		{name: "same type at version 1", sourceType: "popsink_env", sourceVersion: 1, wantMoved: true},
+		{name: "newer version", sourceType: "popsink_env", sourceVersion: 2, wantSummary: "Unsupported Source Schema Version"},
+		{name: "other type", sourceType: "popsink_team", sourceVersion: 0, wantSummary: "Unsupported Source Resource Type"},
+		{name: "other type at version 1", sourceType: "popsink_env_v2", sourceVersion: 1, wantSummary: "Unsupported Source Resource Type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, diags := moveState(t, NewEnvResource(), "popsink_env", tt.sourceType, tt.sourceVersion, rawState)
+			if (state != nil) != tt.wantMoved {
+				t.Fatalf("expected moved %v, got state %v with diagnostics %v", tt.wantMoved, state, diags)
+			}
+			if !tt.wantMoved {
+				if len(diags) != 1 || diags[0].Summary != tt.wantSummary {
+					t.Errorf("expected a %q diagnostic, got %v", tt.wantSummary, diags)
+				}
+				return
+			}
+
+			var model envResourceModel
+			if d := state.Get(context.Background(), &model); d.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", d)
+			}
+
+			if model.ID.ValueString() != "env-123" || model.Name.ValueString() != "production" {
+				t.Errorf("expected env-123 named production, got %s named %s", model.ID.ValueString(), model.Name.ValueString())
+			}
+
+			// Only states at version 0 store the retention block as JSON
+			if (model.Retention != nil) != (tt.sourceVersion == 0) {
+				t.Errorf("expected retention block only when moving from version 0, got %v", model.Retention)
+			}
+		})
+	}
+}
+
+func TestPipelineResource_MoveState(t *testing.T) {
+	rawState := `{"id":"pipeline-123","name":"orders","team_id":"team-123","team_name":"data","state":"live",` +
+		`"json_configuration":"{\"source_name\":\"orders\"}","source_connector_id":"connector-123"}`
+
+	tests := []struct {
+		name                  string
+		sourceVersion         int64
+		wantSourceConnectorID types.String
+	}{
+		// Version 0 states have no source_connector_id, so it is not decoded
+		{name: "version 0", sourceVersion: 0, wantSourceConnectorID: types.StringNull()},
+		{name: "version 1", sourceVersion: 1, wantSourceConnectorID: types.StringValue("connector-123")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, diags := moveState(t, NewPipelineResource(), "popsink_pipeline", "popsink_pipeline", tt.sourceVersion, rawState)
+			if state == nil {
+				t.Fatalf("expected state to be moved, got diagnostics %v", diags)
+			}
+
+			var model pipelineResourceModel
+			if d := state.Get(context.Background(), &model); d.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", d)
+			}
+
+			if model.ID.ValueString() != "pipeline-123" || model.State.ValueString() != "live" {
+				t.Errorf("expected live pipeline-123, got %s %s", model.State.ValueString(), model.ID.ValueString())
+			}
+
+			if model.JSONConfiguration.ValueString() != `{"source_name":"orders"}` {
+				t.Errorf("expected json_configuration to be kept, got %s", model.JSONConfiguration.ValueString())
+			}
+
+			if !model.SourceConnectorID.Equal(tt.wantSourceConnectorID) {
+				t.Errorf("expected source_connector_id %s, got %s", tt.wantSourceConnectorID, model.SourceConnectorID)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The schemas below are frozen copies of the version 0 schemas, as released before schemas were
+// versioned. They must not change with the current schemas, since they decode states written by
+// older releases.
+
+// envResourceModelV0 describes the environment data model at schema version 0
+type envResourceModelV0 struct {
+	ID                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	UseRetention           types.Bool   `tfsdk:"use_retention"`
+	RetentionConfiguration types.String `tfsdk:"retention_configuration"`
+}
+
+// envSchemaV0 returns the environment schema at version 0
+func envSchemaV0() *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":                      schema.StringAttribute{Computed: true},
+			"name":                    schema.StringAttribute{Required: true},
+			"use_retention":           schema.BoolAttribute{Optional: true, Computed: true},
+			"retention_configuration": schema.StringAttribute{Optional: true},
+		},
+	}
+}
+
+// teamResourceModelV0 describes the team data model at schema version 0
+type teamResourceModelV0 struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	EnvID       types.String `tfsdk:"env_id"`
+}
+
+// teamSchemaV0 returns the team schema at version 0
+func teamSchemaV0() *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":          schema.StringAttribute{Computed: true},
+			"name":        schema.StringAttribute{Required: true},
+			"description": schema.StringAttribute{Required: true},
+			"env_id":      schema.StringAttribute{Optional: true},
+		},
+	}
+}
+
+// pipelineResourceModelV0 describes the pipeline data model at schema version 0
+type pipelineResourceModelV0 struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	TeamID            types.String `tfsdk:"team_id"`
+	TeamName          types.String `tfsdk:"team_name"`
+	State             types.String `tfsdk:"state"`
+	JSONConfiguration types.String `tfsdk:"json_configuration"`
+}
+
+// pipelineSchemaV0 returns the pipeline schema at version 0
+func pipelineSchemaV0() *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":                 schema.StringAttribute{Computed: true},
+			"name":               schema.StringAttribute{Required: true},
+			"team_id":            schema.StringAttribute{Required: true},
+			"team_name":          schema.StringAttribute{Computed: true},
+			"state":              schema.StringAttribute{Required: true},
+			"json_configuration": schema.StringAttribute{Required: true},
+		},
+	}
+}
+
+// currentSchema returns the current schema of a resource, used to decode states of the current version
+func currentSchema(ctx context.Context, r resource.Resource) *schema.Schema {
+	resp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, resp)
+	return &resp.Schema
+}
+
+// envModelFromV0 converts an environment state of version 0, moving a retention_configuration
+// JSON string into the typed retention block when every key can be represented by it
+func envModelFromV0(prior envResourceModelV0) envResourceModel {
+	state := envResourceModel{
+		ID:                     prior.ID,
+		Name:                   prior.Name,
+		UseRetention:           prior.UseRetention,
+		RetentionConfiguration: prior.RetentionConfiguration,
+	}
+
+	if state.RetentionConfiguration.ValueString() == "" {
+		return state
+	}
+
+	// Invalid JSON is kept as is and reported by the retention_configuration validators
+	var config map[string]any
+	if err := json.Unmarshal([]byte(state.RetentionConfiguration.ValueString()), &config); err != nil {
+		return state
+	}
+
+	normalizedConfig := normalizeRetentionConfig(config)
+	if len(normalizedConfig) > 0 && isTypedRetentionConfig(normalizedConfig) {
+		state.Retention = newEnvRetentionModel(normalizedConfig, nil)
+		state.RetentionConfiguration = types.StringNull()
+	}
+
+	return state
+}
+
+// teamModelFromV0 converts a team state of version 0, whose attributes are unchanged
+func teamModelFromV0(prior teamResourceModelV0) teamResourceModel {
+	return teamResourceModel{
+		ID:          prior.ID,
+		Name:        prior.Name,
+		Description: prior.Description,
+		EnvID:       prior.EnvID,
+	}
+}
+
+// pipelineModelFromV0 converts a pipeline state of version 0. Attributes added since are null
+// until the next refresh.
+func pipelineModelFromV0(prior pipelineResourceModelV0) pipelineResourceModel {
+	return pipelineResourceModel{
+		ID:                prior.ID,
+		Name:              prior.Name,
+		TeamID:            prior.TeamID,
+		TeamName:          prior.TeamName,
+		State:             prior.State,
+		JSONConfiguration: prior.JSONConfiguration,
+		Transforms:        types.ListNull(types.ObjectType{AttrTypes: transformStepAttrTypes()}),
+	}
+}
+
+// upgradeStateV0 returns a state upgrader decoding a version 0 state into P and converting it with upgrade
+func upgradeStateV0[P, T any](upgrade func(P) T) func(context.Context, resource.UpgradeStateRequest, *resource.UpgradeStateResponse) {
+	return func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+		var prior P
+		resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state := upgrade(prior)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	}
+}
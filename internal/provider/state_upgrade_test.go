@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// upgradeState upgrades a raw JSON state of the given resource type through the provider server
+func upgradeState(t *testing.T, r resource.Resource, typeName string, version int64, rawState string) tfsdk.State {
+	t.Helper()
+
+	ctx := context.Background()
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	resp, err := server.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+		TypeName: typeName,
+		Version:  version,
+		RawState: &tfprotov6.RawState{JSON: []byte(rawState)},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	schemaType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	raw, err := resp.UpgradedState.Unmarshal(schemaType)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return tfsdk.State{Schema: schemaResp.Schema, Raw: raw}
+}
+
+func TestEnvResource_UpgradeStateV0(t *testing.T) {
+	tests := []struct {
+		name                string
+		rawState            string
+		wantRetention       bool
+		wantBootstrapServer string
+		wantRetentionConfig string
+	}{
+		{
+			name: "typed retention configuration",
+			rawState: `{"id":"env-123","name":"production","use_retention":true,` +
+				`"retention_configuration":"{\"bootstrap_server\":\"kafka:9092\",\"security_protocol\":\"SASL_SSL\",` +
+				`\"sasl_mechanism\":\"PLAIN\",\"sasl_username\":\"user\",\"sasl_password\":\"secret\"}"}`,
+			wantRetention:       true,
+			wantBootstrapServer: "kafka:9092",
+		},
+		{
+			name: "untyped retention configuration",
+			rawState: `{"id":"env-123","name":"production","use_retention":true,` +
+				`"retention_configuration":"{\"bootstrap_server\":\"kafka:9092\",\"linger_ms\":5}"}`,
+			wantRetentionConfig: `{"bootstrap_server":"kafka:9092","linger_ms":5}`,
+		},
+		{
+			name: "invalid retention configuration",
+			rawState: `{"id":"env-123","name":"production","use_retention":true,` +
+				`"retention_configuration":"{not json"}`,
+			wantRetentionConfig: `{not json`,
+		},
+		{
+			name:     "without retention",
+			rawState: `{"id":"env-123","name":"development","use_retention":false,"retention_configuration":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := upgradeState(t, NewEnvResource(), "popsink_env", 0, tt.rawState)
+
+			var model envResourceModel
+			if diags := state.Get(context.Background(), &model); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if model.ID.ValueString() != "env-123" {
+				t.Errorf("expected id env-123, got %s", model.ID.ValueString())
+			}
+
+			if (model.Retention != nil) != tt.wantRetention {
+				t.Fatalf("expected retention block %v, got %v", tt.wantRetention, model.Retention)
+			}
+
+			if tt.wantRetention {
+				if model.Retention.BootstrapServer.ValueString() != tt.wantBootstrapServer {
+					t.Errorf("expected bootstrap_server %s, got %s", tt.wantBootstrapServer, model.Retention.BootstrapServer.ValueString())
+				}
+				if model.Retention.SASLPassword.ValueString() != "secret" {
+					t.Errorf("expected sasl_password to be kept, got %s", model.Retention.SASLPassword.ValueString())
+				}
+				if !model.RetentionConfiguration.IsNull() {
+					t.Errorf("expected null retention_configuration, got %s", model.RetentionConfiguration.ValueString())
+				}
+				return
+			}
+
+			if model.RetentionConfiguration.ValueString() != tt.wantRetentionConfig {
+				t.Errorf("expected retention_configuration %q, got %q", tt.wantRetentionConfig, model.RetentionConfiguration.ValueString())
+			}
+		})
+	}
+}
+
+// A configuration still using retention_configuration after the upgrade plans one update that
+// sends the same payload, after which the JSON form is stored again and the plan is empty
+func TestEnvResource_UpgradeStateV0_Converges(t *testing.T) {
+	retentionJSON := `{"bootstrap_server":"kafka:9092","security_protocol":"SASL_SSL","sasl_password":"secret"}`
+	upgraded := envModelFromV0(envResourceModelV0{
+		ID:                     types.StringValue("env-123"),
+		Name:                   types.StringValue("production"),
+		UseRetention:           types.BoolValue(true),
+		RetentionConfiguration: types.StringValue(retentionJSON),
+	})
+	if upgraded.Retention == nil {
+		t.Fatalf("expected the retention configuration to be moved into the block")
+	}
+
+	plan := upgraded
+	plan.Retention = nil
+	plan.RetentionConfiguration = types.StringValue(retentionJSON)
+
+	if !retentionBlockChanged(plan.Retention, upgraded.Retention) && plan.RetentionConfiguration.Equal(upgraded.RetentionConfiguration) {
+		t.Fatalf("expected the first plan to update the environment")
+	}
+
+	planConfig, diags := plan.retentionConfiguration()
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	stateConfig, diags := upgraded.retentionConfiguration()
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !reflect.DeepEqual(planConfig, stateConfig) {
+		t.Errorf("expected the update to send the same payload, got %v and %v", planConfig, stateConfig)
+	}
+
+	// The API returns the configuration that was sent
+	if diags := plan.setRetentionConfiguration(planConfig); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var want, got map[string]any
+	_ = json.Unmarshal([]byte(retentionJSON), &want)
+	_ = json.Unmarshal([]byte(plan.RetentionConfiguration.ValueString()), &got)
+	if plan.Retention != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("expected the JSON form to be stored again, got %s and %v", plan.RetentionConfiguration.ValueString(), plan.Retention)
+	}
+}
+
+func TestTeamResource_UpgradeStateV0(t *testing.T) {
+	rawState := `{"id":"team-123","name":"data","description":"Data team","env_id":"env-123"}`
+
+	state := upgradeState(t, NewTeamResource(), "popsink_team", 0, rawState)
+
+	var model teamResourceModel
+	if diags := state.Get(context.Background(), &model); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if model.ID.ValueString() != "team-123" || model.EnvID.ValueString() != "env-123" {
+		t.Errorf("expected team-123 in env-123, got %s in %s", model.ID.ValueString(), model.EnvID.ValueString())
+	}
+}
+
+func TestPipelineResource_UpgradeStateV0(t *testing.T) {
+	rawState := `{"id":"pipeline-123","name":"orders","team_id":"team-123","team_name":"data","state":"draft",` +
+		`"json_configuration":"{\"source_name\":\"orders\"}"}`
+
+	state := upgradeState(t, NewPipelineResource(), "popsink_pipeline", 0, rawState)
+
+	var model pipelineResourceModel
+	if diags := state.Get(context.Background(), &model); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if model.JSONConfiguration.ValueString() != `{"source_name":"orders"}` {
+		t.Errorf("expected json_configuration to be kept, got %s", model.JSONConfiguration.ValueString())
+	}
+
+	if !model.SourceConnectorID.IsNull() || !model.Transforms.IsNull() {
+		t.Errorf("expected attributes missing from the prior state to be null")
+	}
+}
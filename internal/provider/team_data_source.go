@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ datasource.DataSource                     = &teamDataSource{}
+	_ datasource.DataSourceWithConfigure        = &teamDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &teamDataSource{}
+)
+
+// NewTeamDataSource creates a new team data source
+func NewTeamDataSource() datasource.DataSource {
+	return &teamDataSource{}
+}
+
+// teamDataSource defines the data source implementation
+type teamDataSource struct {
+	client *client.Client
+}
+
+// teamDataSourceModel describes the data source data model
+type teamDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	EnvID       types.String `tfsdk:"env_id"`
+	Description types.String `tfsdk:"description"`
+}
+
+// Metadata returns the data source type name
+func (d *teamDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+// Schema defines the data source schema
+func (d *teamDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a Popsink team by ID, or by name within an optional environment.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the team. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the team. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"env_id": schema.StringAttribute{
+				Description: "The UUID of the environment the team belongs to. When looking up by name, only teams of this environment are considered.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Short description of the team.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source
+func (d *teamDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// ConfigValidators returns the data source-level configuration validators
+func (d *teamDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+		datasourcevalidator.Conflicting(path.MatchRoot("id"), path.MatchRoot("env_id")),
+	}
+}
+
+// Read refreshes the data source state
+func (d *teamDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config teamDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var team *client.TeamRead
+	if !config.ID.IsNull() {
+		var err error
+		team, err = d.client.GetTeam(ctx, config.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Team",
+				fmt.Sprintf("Could not read team %s: %s", config.ID.ValueString(), err.Error()),
+			)
+			return
+		}
+
+		if team == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Team Not Found",
+				fmt.Sprintf("No team has the ID %s.", config.ID.ValueString()),
+			)
+			return
+		}
+	} else {
+		name := config.Name.ValueString()
+		envID := config.EnvID.ValueString()
+		teams, err := d.client.ListTeams(ctx, client.TeamFilter{Name: name, EnvID: envID})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Listing Teams",
+				fmt.Sprintf("Could not list teams: %s", err.Error()),
+			)
+			return
+		}
+
+		// The API filter may match partially, so only keep exact matches
+		var ids []string
+		for i := range teams {
+			if teams[i].Name != name || (envID != "" && (teams[i].EnvID == nil || *teams[i].EnvID != envID)) {
+				continue
+			}
+			team = &teams[i]
+			ids = append(ids, teams[i].ID)
+		}
+
+		scope := ""
+		if envID != "" {
+			scope = fmt.Sprintf(" in environment %s", envID)
+		}
+
+		switch len(ids) {
+		case 0:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Team Not Found",
+				fmt.Sprintf("No team is named %q%s.", name, scope),
+			)
+			return
+		case 1:
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Multiple Teams Found",
+				fmt.Sprintf("%d teams are named %q%s: %s. Set env_id or use id to select one.", len(ids), name, scope, strings.Join(ids, ", ")),
+			)
+			return
+		}
+	}
+
+	config.ID = types.StringValue(team.ID)
+	config.Name = types.StringValue(team.Name)
+	config.EnvID = types.StringPointerValue(team.EnvID)
+	config.Description = types.StringValue(team.Description)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
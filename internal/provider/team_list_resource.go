@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ list.ListResource              = &teamListResource{}
+	_ list.ListResourceWithConfigure = &teamListResource{}
+)
+
+// NewTeamListResource creates a new team list resource
+func NewTeamListResource() list.ListResource {
+	return &teamListResource{}
+}
+
+// teamListResource defines the list resource implementation
+type teamListResource struct {
+	client *client.Client
+}
+
+// teamListResourceModel describes the list resource configuration model
+type teamListResourceModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+	EnvID     types.String `tfsdk:"env_id"`
+}
+
+// Metadata returns the resource type name
+func (r *teamListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+// ListResourceConfigSchema defines the list resource configuration schema
+func (r *teamListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the Popsink teams of the organization.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Description: "Only list teams whose name matches this regular expression.",
+				Optional:    true,
+				Validators: []validator.String{
+					regexpValidator{},
+				},
+			},
+			"env_id": schema.StringAttribute{
+				Description: "Only list teams of this environment.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the list resource
+func (r *teamListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// List streams the teams matching the configuration
+func (r *teamListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config teamListResourceModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	nameFilter, err := nameRegexp(config.NameRegex)
+	if err != nil {
+		diags.AddError(
+			"Invalid Regular Expression",
+			fmt.Sprintf("Could not compile name_regex: %s", err.Error()),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	envID := config.EnvID.ValueString()
+	teams, err := r.client.ListTeams(ctx, client.TeamFilter{EnvID: envID})
+	if err != nil {
+		diags.AddError(
+			"Error Listing Teams",
+			fmt.Sprintf("Could not list teams: %s", err.Error()),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for _, team := range teams {
+			if nameFilter != nil && !nameFilter.MatchString(team.Name) {
+				continue
+			}
+			if envID != "" && (team.EnvID == nil || *team.EnvID != envID) {
+				continue
+			}
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			count++
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = team.Name
+			result.Diagnostics.Append(setResourceIdentity(ctx, r.client, result.Identity, types.StringValue(team.ID))...)
+
+			if req.IncludeResource {
+				model := teamResourceModel{
+					ID:          types.StringValue(team.ID),
+					Name:        types.StringValue(team.Name),
+					Description: types.StringValue(team.Description),
+					EnvID:       types.StringPointerValue(team.EnvID),
+				}
+				result.Diagnostics.Append(result.Resource.Set(ctx, &model)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
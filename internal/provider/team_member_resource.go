@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+// Valid team member roles
+var validTeamMemberRoles = []string{
+	"admin",
+	"member",
+	"viewer",
+}
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ resource.Resource                     = &teamMemberResource{}
+	_ resource.ResourceWithConfigure        = &teamMemberResource{}
+	_ resource.ResourceWithConfigValidators = &teamMemberResource{}
+	_ resource.ResourceWithImportState      = &teamMemberResource{}
+)
+
+// NewTeamMemberResource creates a new team member resource
+func NewTeamMemberResource() resource.Resource {
+	return &teamMemberResource{}
+}
+
+// teamMemberResource defines the resource implementation
+type teamMemberResource struct {
+	client *client.Client
+}
+
+// teamMemberResourceModel describes the resource data model
+type teamMemberResourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	TeamID types.String `tfsdk:"team_id"`
+	UserID types.String `tfsdk:"user_id"`
+	Email  types.String `tfsdk:"email"`
+	Role   types.String `tfsdk:"role"`
+}
+
+// Metadata returns the resource type name
+func (r *teamMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_member"
+}
+
+// Schema defines the resource schema
+func (r *teamMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the membership of a single user in a Popsink team.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier of the membership, in the form team_id/user_id.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				Description: "The UUID of the team.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "The UUID of the user. Exactly one of user_id or email must be set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Description: "The email address of the user. Exactly one of user_id or email must be set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: "The role of the user in the team. Valid values: admin, member, viewer.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(validTeamMemberRoles...),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource
+func (r *teamMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ConfigValidators returns the resource-level configuration validators
+func (r *teamMemberResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("user_id"),
+			path.MatchRoot("email"),
+		),
+	}
+}
+
+// Create creates the resource
+func (r *teamMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan teamMemberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Add team member
+	createReq := &client.TeamMemberCreate{
+		Role: plan.Role.ValueString(),
+	}
+
+	if !plan.UserID.IsNull() && !plan.UserID.IsUnknown() {
+		userID := plan.UserID.ValueString()
+		createReq.UserID = &userID
+	}
+
+	if !plan.Email.IsNull() && !plan.Email.IsUnknown() {
+		email := plan.Email.ValueString()
+		createReq.Email = &email
+	}
+
+	member, err := r.client.AddTeamMember(ctx, plan.TeamID.ValueString(), createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Adding Team Member",
+			fmt.Sprintf("Could not add member to team %s: %s", plan.TeamID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// Update state with the added member
+	plan.ID = types.StringValue(member.TeamID + "/" + member.UserID)
+	plan.TeamID = types.StringValue(member.TeamID)
+	plan.UserID = types.StringValue(member.UserID)
+	plan.Email = types.StringValue(member.Email)
+	plan.Role = types.StringValue(member.Role)
+
+	tflog.Info(ctx, "Added team member", map[string]any{"team_id": member.TeamID, "user_id": member.UserID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the resource state
+func (r *teamMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state teamMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := r.client.GetTeamMember(ctx, state.TeamID.ValueString(), state.UserID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Team Member",
+			fmt.Sprintf("Could not read member %s of team %s: %s", state.UserID.ValueString(), state.TeamID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// If the membership is not found, remove from state
+	if member == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Update state
+	state.ID = types.StringValue(member.TeamID + "/" + member.UserID)
+	state.TeamID = types.StringValue(member.TeamID)
+	state.UserID = types.StringValue(member.UserID)
+	state.Email = types.StringValue(member.Email)
+	state.Role = types.StringValue(member.Role)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource
+func (r *teamMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan teamMemberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state teamMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the role can be updated in place
+	role := plan.Role.ValueString()
+	member, err := r.client.UpdateTeamMember(ctx, state.TeamID.ValueString(), state.UserID.ValueString(), &client.TeamMemberUpdate{
+		Role: &role,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Team Member",
+			fmt.Sprintf("Could not update member %s of team %s: %s", state.UserID.ValueString(), state.TeamID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// Update state
+	plan.ID = types.StringValue(member.TeamID + "/" + member.UserID)
+	plan.TeamID = types.StringValue(member.TeamID)
+	plan.UserID = types.StringValue(member.UserID)
+	plan.Email = types.StringValue(member.Email)
+	plan.Role = types.StringValue(member.Role)
+
+	tflog.Info(ctx, "Updated team member", map[string]any{"team_id": member.TeamID, "user_id": member.UserID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource
+func (r *teamMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state teamMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveTeamMember(ctx, state.TeamID.ValueString(), state.UserID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Removing Team Member",
+			fmt.Sprintf("Could not remove member %s from team %s: %s", state.UserID.ValueString(), state.TeamID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Removed team member", map[string]any{"team_id": state.TeamID.ValueString(), "user_id": state.UserID.ValueString()})
+}
+
+// ImportState imports the resource state using an ID in the form team_id/user_id
+func (r *teamMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, userID, found := strings.Cut(req.ID, "/")
+	if !found || teamID == "" || userID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID in the form team_id/user_id, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), teamID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userID)...)
+}
@@ -0,0 +1,393 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ resource.Resource                = &teamMembersResource{}
+	_ resource.ResourceWithConfigure   = &teamMembersResource{}
+	_ resource.ResourceWithImportState = &teamMembersResource{}
+)
+
+// NewTeamMembersResource creates a new authoritative team members resource
+func NewTeamMembersResource() resource.Resource {
+	return &teamMembersResource{}
+}
+
+// teamMembersResource defines the resource implementation
+type teamMembersResource struct {
+	client *client.Client
+}
+
+// teamMembersResourceModel describes the resource data model
+type teamMembersResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	TeamID  types.String `tfsdk:"team_id"`
+	Members types.Set    `tfsdk:"members"`
+}
+
+// teamMembersMemberModel describes a single member of the members set
+type teamMembersMemberModel struct {
+	UserID types.String `tfsdk:"user_id"`
+	Email  types.String `tfsdk:"email"`
+	Role   types.String `tfsdk:"role"`
+}
+
+// teamMembersMemberAttrTypes returns the attribute types of a member of the members set
+func teamMembersMemberAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"user_id": types.StringType,
+		"email":   types.StringType,
+		"role":    types.StringType,
+	}
+}
+
+// Metadata returns the resource type name
+func (r *teamMembersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_members"
+}
+
+// Schema defines the resource schema
+func (r *teamMembersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Authoritatively manages the members of a Popsink team. " +
+			"Members that are not listed are removed from the team.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier of the resource, equal to team_id.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				Description: "The UUID of the team.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"members": schema.SetNestedAttribute{
+				Description: "The complete set of team members.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_id": schema.StringAttribute{
+							Description: "The UUID of the user. Exactly one of user_id or email must be set.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("email")),
+							},
+						},
+						"email": schema.StringAttribute{
+							Description: "The email address of the user. Exactly one of user_id or email must be set.",
+							Optional:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "The role of the user in the team. Valid values: admin, member, viewer.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(validTeamMemberRoles...),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource
+func (r *teamMembersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource
+func (r *teamMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan teamMembersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcile(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Created team members", map[string]any{"team_id": plan.TeamID.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the resource state
+func (r *teamMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state teamMembersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := r.client.ListTeamMembers(ctx, state.TeamID.ValueString())
+	// If the team was deleted, its members are gone too
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Team Members",
+			fmt.Sprintf("Could not list members of team %s: %s", state.TeamID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.setMembers(ctx, members)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource
+func (r *teamMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan teamMembersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcile(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Updated team members", map[string]any{"team_id": plan.TeamID.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource
+func (r *teamMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state teamMembersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var members []teamMembersMemberModel
+	resp.Diagnostics.Append(state.Members.ElementsAs(ctx, &members, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.client.ListTeamMembers(ctx, state.TeamID.ValueString())
+	if client.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Team Members",
+			fmt.Sprintf("Could not list members of team %s: %s", state.TeamID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// Only remove the members managed by this resource
+	for _, member := range current {
+		if findTeamMember(members, member) == nil {
+			continue
+		}
+
+		if err := r.client.RemoveTeamMember(ctx, state.TeamID.ValueString(), member.UserID); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Removing Team Member",
+				fmt.Sprintf("Could not remove member %s from team %s: %s", member.UserID, state.TeamID.ValueString(), err.Error()),
+			)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Deleted team members", map[string]any{"team_id": state.TeamID.ValueString()})
+}
+
+// ImportState imports the resource state using the team ID
+func (r *teamMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), req.ID)...)
+}
+
+// reconcile adds, updates and removes team members so that the team matches the planned members
+func (r *teamMembersResource) reconcile(ctx context.Context, plan *teamMembersResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	teamID := plan.TeamID.ValueString()
+
+	var desired []teamMembersMemberModel
+	diags.Append(plan.Members.ElementsAs(ctx, &desired, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	current, err := r.client.ListTeamMembers(ctx, teamID)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Team Members",
+			fmt.Sprintf("Could not list members of team %s: %s", teamID, err.Error()),
+		)
+		return diags
+	}
+
+	// Remove members that are no longer desired and update changed roles
+	present := make([]bool, len(desired))
+	for _, member := range current {
+		index := findTeamMemberIndex(desired, member)
+		if index < 0 {
+			if err := r.client.RemoveTeamMember(ctx, teamID, member.UserID); err != nil {
+				diags.AddError(
+					"Error Removing Team Member",
+					fmt.Sprintf("Could not remove member %s from team %s: %s", member.UserID, teamID, err.Error()),
+				)
+				return diags
+			}
+			continue
+		}
+
+		present[index] = true
+		role := desired[index].Role.ValueString()
+		if member.Role == role {
+			continue
+		}
+
+		if _, err := r.client.UpdateTeamMember(ctx, teamID, member.UserID, &client.TeamMemberUpdate{Role: &role}); err != nil {
+			diags.AddError(
+				"Error Updating Team Member",
+				fmt.Sprintf("Could not update member %s of team %s: %s", member.UserID, teamID, err.Error()),
+			)
+			return diags
+		}
+	}
+
+	// Add members that are not part of the team yet
+	for index, member := range desired {
+		if present[index] {
+			continue
+		}
+
+		createReq := &client.TeamMemberCreate{
+			Role: member.Role.ValueString(),
+		}
+		if !member.UserID.IsNull() {
+			userID := member.UserID.ValueString()
+			createReq.UserID = &userID
+		}
+		if !member.Email.IsNull() {
+			email := member.Email.ValueString()
+			createReq.Email = &email
+		}
+
+		if _, err := r.client.AddTeamMember(ctx, teamID, createReq); err != nil {
+			diags.AddError(
+				"Error Adding Team Member",
+				fmt.Sprintf("Could not add member to team %s: %s", teamID, err.Error()),
+			)
+			return diags
+		}
+	}
+
+	plan.ID = types.StringValue(teamID)
+
+	return diags
+}
+
+// setMembers stores the API team members in the model, keeping the user_id or email
+// representation used by the prior state for each member. Members without a prior
+// representation, such as after an import, are stored by user_id: a configuration
+// listing them by email plans an update that only rewrites the state.
+func (m *teamMembersResourceModel) setMembers(ctx context.Context, members []client.TeamMemberRead) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var prior []teamMembersMemberModel
+	if !m.Members.IsNull() && !m.Members.IsUnknown() {
+		diags.Append(m.Members.ElementsAs(ctx, &prior, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	elements := make([]teamMembersMemberModel, 0, len(members))
+	for _, member := range members {
+		element := teamMembersMemberModel{
+			UserID: types.StringValue(member.UserID),
+			Email:  types.StringNull(),
+			Role:   types.StringValue(member.Role),
+		}
+
+		if match := findTeamMember(prior, member); match != nil && !match.Email.IsNull() {
+			element.UserID = types.StringNull()
+			element.Email = match.Email
+		}
+
+		elements = append(elements, element)
+	}
+
+	set, setDiags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: teamMembersMemberAttrTypes()}, elements)
+	diags.Append(setDiags...)
+
+	m.ID = m.TeamID
+	m.Members = set
+
+	return diags
+}
+
+// findTeamMember returns the member of the set matching an API team member, if any
+func findTeamMember(members []teamMembersMemberModel, member client.TeamMemberRead) *teamMembersMemberModel {
+	index := findTeamMemberIndex(members, member)
+	if index < 0 {
+		return nil
+	}
+	return &members[index]
+}
+
+// findTeamMemberIndex returns the index of the member of the set matching an API team member, or -1
+func findTeamMemberIndex(members []teamMembersMemberModel, member client.TeamMemberRead) int {
+	for index, candidate := range members {
+		if !candidate.UserID.IsNull() && candidate.UserID.ValueString() == member.UserID {
+			return index
+		}
+		if !candidate.Email.IsNull() && strings.EqualFold(candidate.Email.ValueString(), member.Email) {
+			return index
+		}
+	}
+	return -1
+}
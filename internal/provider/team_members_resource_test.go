@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+// teamMembersServer serves the members of team-123, or a 404 when members is nil, and records the requests it receives
+type teamMembersServer struct {
+	mu       sync.Mutex
+	members  []client.TeamMemberRead
+	requests []string
+}
+
+func (s *teamMembersServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r.Method != http.MethodGet {
+		request := r.Method + " " + r.URL.Path
+		if r.Method != http.MethodDelete {
+			body := map[string]any{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			encoded, _ := json.Marshal(body)
+			request += " " + string(encoded)
+		}
+		s.requests = append(s.requests, request)
+	}
+
+	if s.members == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	switch r.Method {
+	case http.MethodGet:
+		_ = json.NewEncoder(w).Encode(client.Page[client.TeamMemberRead]{Items: s.members, Total: len(s.members), Page: 1, Size: 100, Pages: 1})
+	case http.MethodDelete:
+	default:
+		_ = json.NewEncoder(w).Encode(client.TeamMemberRead{})
+	}
+}
+
+// teamMembersValue builds a members set from the given members
+func teamMembersValue(t *testing.T, members ...teamMembersMemberModel) types.Set {
+	t.Helper()
+
+	set, diags := types.SetValueFrom(context.Background(), types.ObjectType{AttrTypes: teamMembersMemberAttrTypes()}, members)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	return set
+}
+
+func TestTeamMembersResource_Reconcile(t *testing.T) {
+	server := &teamMembersServer{
+		members: []client.TeamMemberRead{
+			{TeamID: "team-123", UserID: "user-1", Email: "one@example.com", Role: "admin"},
+			{TeamID: "team-123", UserID: "user-2", Email: "two@example.com", Role: "member"},
+			{TeamID: "team-123", UserID: "user-3", Email: "three@example.com", Role: "member"},
+		},
+	}
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	r := &teamMembersResource{client: client.NewClient(httpServer.URL, "test-token")}
+	plan := teamMembersResourceModel{
+		TeamID: types.StringValue("team-123"),
+		Members: teamMembersValue(t,
+			// Role changed
+			teamMembersMemberModel{UserID: types.StringValue("user-1"), Email: types.StringNull(), Role: types.StringValue("member")},
+			// Unchanged, matched by email
+			teamMembersMemberModel{UserID: types.StringNull(), Email: types.StringValue("TWO@example.com"), Role: types.StringValue("member")},
+			// New member
+			teamMembersMemberModel{UserID: types.StringNull(), Email: types.StringValue("four@example.com"), Role: types.StringValue("admin")},
+		),
+	}
+
+	if diags := r.reconcile(context.Background(), &plan); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	want := []string{
+		`PATCH /teams/team-123/members/user-1 {"role":"member"}`,
+		`DELETE /teams/team-123/members/user-3`,
+		`POST /teams/team-123/members/ {"email":"four@example.com","role":"admin"}`,
+	}
+	slices.Sort(want)
+	got := slices.Sorted(slices.Values(server.requests))
+	if !slices.Equal(got, want) {
+		t.Errorf("expected requests %v, got %v", want, got)
+	}
+
+	if plan.ID.ValueString() != "team-123" {
+		t.Errorf("expected id team-123, got %s", plan.ID.ValueString())
+	}
+}
+
+func TestTeamMembersResource_ReadDeletedTeam(t *testing.T) {
+	httpServer := httptest.NewServer(&teamMembersServer{})
+	defer httpServer.Close()
+
+	ctx := context.Background()
+	r := &teamMembersResource{client: client.NewClient(httpServer.URL, "test-token")}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.Set(ctx, &teamMembersResourceModel{
+		ID:      types.StringValue("team-123"),
+		TeamID:  types.StringValue("team-123"),
+		Members: types.SetValueMust(types.ObjectType{AttrTypes: teamMembersMemberAttrTypes()}, []attr.Value{}),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if !resp.State.Raw.IsNull() {
+		t.Errorf("expected the resource to be removed from state")
+	}
+}
+
+func TestTeamMembersResource_ReconcileAfterImport(t *testing.T) {
+	server := &teamMembersServer{
+		members: []client.TeamMemberRead{
+			{TeamID: "team-123", UserID: "user-1", Email: "one@example.com", Role: "admin"},
+			{TeamID: "team-123", UserID: "user-2", Email: "two@example.com", Role: "member"},
+		},
+	}
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	ctx := context.Background()
+	r := &teamMembersResource{client: client.NewClient(httpServer.URL, "test-token")}
+
+	// Imported members are stored by user_id
+	state := teamMembersResourceModel{
+		TeamID:  types.StringValue("team-123"),
+		Members: types.SetNull(types.ObjectType{AttrTypes: teamMembersMemberAttrTypes()}),
+	}
+	if diags := state.setMembers(ctx, server.members); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	imported := teamMembersValue(t,
+		teamMembersMemberModel{UserID: types.StringValue("user-1"), Email: types.StringNull(), Role: types.StringValue("admin")},
+		teamMembersMemberModel{UserID: types.StringValue("user-2"), Email: types.StringNull(), Role: types.StringValue("member")},
+	)
+	if !state.Members.Equal(imported) {
+		t.Fatalf("expected members %v, got %v", imported, state.Members)
+	}
+
+	// A configuration listing the same members by email only rewrites the state
+	plan := teamMembersResourceModel{
+		TeamID: types.StringValue("team-123"),
+		Members: teamMembersValue(t,
+			teamMembersMemberModel{UserID: types.StringNull(), Email: types.StringValue("one@example.com"), Role: types.StringValue("admin")},
+			teamMembersMemberModel{UserID: types.StringNull(), Email: types.StringValue("two@example.com"), Role: types.StringValue("member")},
+		),
+	}
+	want := plan.Members
+
+	if diags := r.reconcile(ctx, &plan); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if len(server.requests) != 0 {
+		t.Errorf("expected no requests, got %v", server.requests)
+	}
+
+	// The next refresh keeps the email representation
+	if diags := plan.setMembers(ctx, server.members); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !plan.Members.Equal(want) {
+		t.Errorf("expected members %v, got %v", want, plan.Members)
+	}
+}
@@ -16,9 +16,11 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces
 var (
-	_ resource.Resource                = &teamResource{}
-	_ resource.ResourceWithConfigure   = &teamResource{}
-	_ resource.ResourceWithImportState = &teamResource{}
+	_ resource.Resource                 = &teamResource{}
+	_ resource.ResourceWithConfigure    = &teamResource{}
+	_ resource.ResourceWithImportState  = &teamResource{}
+	_ resource.ResourceWithIdentity     = &teamResource{}
+	_ resource.ResourceWithUpgradeState = &teamResource{}
 )
 
 // NewTeamResource creates a new team resource
@@ -47,6 +49,7 @@ func (r *teamResource) Metadata(ctx context.Context, req resource.MetadataReques
 // Schema defines the resource schema
 func (r *teamResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     1,
 		Description: "Manages a Popsink team resource.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -72,6 +75,11 @@ func (r *teamResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 	}
 }
 
+// IdentitySchema defines the resource identity schema
+func (r *teamResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema("team")
+}
+
 // Configure adds the provider configured client to the resource
 func (r *teamResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -131,6 +139,7 @@ func (r *teamResource) Create(ctx context.Context, req resource.CreateRequest, r
 	tflog.Info(ctx, "Created team", map[string]any{"id": team.ID})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, r.client, resp.Identity, plan.ID)...)
 }
 
 // Read refreshes the resource state
@@ -166,6 +175,7 @@ func (r *teamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, r.client, resp.Identity, state.ID)...)
 }
 
 // Update updates the resource
@@ -228,6 +238,7 @@ func (r *teamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	tflog.Info(ctx, "Updated team", map[string]any{"id": team.ID})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, r.client, resp.Identity, plan.ID)...)
 }
 
 // Delete deletes the resource
@@ -250,7 +261,32 @@ func (r *teamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	tflog.Info(ctx, "Deleted team", map[string]any{"id": state.ID.ValueString()})
 }
 
-// ImportState imports the resource state
+// ImportState imports the resource state from an identity or an ID of the form team_id, team_name or env_name/team_name
 func (r *teamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if req.ID == "" {
+		importStateFromIdentity(ctx, r.client, req, resp)
+		return
+	}
+
+	env, team := splitImportID(req.ID)
+	id, err := resolveTeamID(ctx, r.client, env, team)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Team",
+			fmt.Sprintf("Could not resolve import ID %q: %s", req.ID, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// UpgradeState upgrades states written with prior schema versions
+func (r *teamResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   teamSchemaV0(),
+			StateUpgrader: upgradeStateV0(teamModelFromV0),
+		},
+	}
 }
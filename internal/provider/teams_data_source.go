@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ datasource.DataSource              = &teamsDataSource{}
+	_ datasource.DataSourceWithConfigure = &teamsDataSource{}
+)
+
+// NewTeamsDataSource creates a new teams data source
+func NewTeamsDataSource() datasource.DataSource {
+	return &teamsDataSource{}
+}
+
+// teamsDataSource defines the data source implementation
+type teamsDataSource struct {
+	client *client.Client
+}
+
+// teamsDataSourceModel describes the data source data model
+type teamsDataSourceModel struct {
+	NameRegex types.String         `tfsdk:"name_regex"`
+	EnvID     types.String         `tfsdk:"env_id"`
+	Teams     []teamsDataTeamModel `tfsdk:"teams"`
+}
+
+// teamsDataTeamModel describes a single team of the teams list
+type teamsDataTeamModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	EnvID       types.String `tfsdk:"env_id"`
+}
+
+// Metadata returns the data source type name
+func (d *teamsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_teams"
+}
+
+// Schema defines the data source schema
+func (d *teamsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the Popsink teams of the organization.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Description: "Only return teams whose name matches this regular expression.",
+				Optional:    true,
+				Validators: []validator.String{
+					regexpValidator{},
+				},
+			},
+			"env_id": schema.StringAttribute{
+				Description: "Only return teams of this environment.",
+				Optional:    true,
+			},
+			"teams": schema.ListNestedAttribute{
+				Description: "The teams matching the filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the team.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the team.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Short description of the team.",
+							Computed:    true,
+						},
+						"env_id": schema.StringAttribute{
+							Description: "The UUID of the environment the team belongs to.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source
+func (d *teamsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the data source state
+func (d *teamsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config teamsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameFilter, err := nameRegexp(config.NameRegex)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Regular Expression",
+			fmt.Sprintf("Could not compile name_regex: %s", err.Error()),
+		)
+		return
+	}
+
+	envID := config.EnvID.ValueString()
+	teams, err := d.client.ListTeams(ctx, client.TeamFilter{EnvID: envID})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Teams",
+			fmt.Sprintf("Could not list teams: %s", err.Error()),
+		)
+		return
+	}
+
+	config.Teams = make([]teamsDataTeamModel, 0, len(teams))
+	for _, team := range teams {
+		if nameFilter != nil && !nameFilter.MatchString(team.Name) {
+			continue
+		}
+		if envID != "" && (team.EnvID == nil || *team.EnvID != envID) {
+			continue
+		}
+
+		config.Teams = append(config.Teams, teamsDataTeamModel{
+			ID:          types.StringValue(team.ID),
+			Name:        types.StringValue(team.Name),
+			Description: types.StringValue(team.Description),
+			EnvID:       types.StringPointerValue(team.EnvID),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
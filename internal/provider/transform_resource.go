@@ -0,0 +1,304 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ resource.Resource                     = &transformResource{}
+	_ resource.ResourceWithConfigure        = &transformResource{}
+	_ resource.ResourceWithConfigValidators = &transformResource{}
+	_ resource.ResourceWithModifyPlan       = &transformResource{}
+	_ resource.ResourceWithImportState      = &transformResource{}
+)
+
+// NewTransformResource creates a new transform resource
+func NewTransformResource() resource.Resource {
+	return &transformResource{}
+}
+
+// transformResource defines the resource implementation
+type transformResource struct {
+	client *client.Client
+}
+
+// transformResourceModel describes the resource data model
+type transformResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	TeamID      types.String `tfsdk:"team_id"`
+	Steps       types.List   `tfsdk:"steps"`
+	Revision    types.Int64  `tfsdk:"revision"`
+}
+
+// setTransform copies the transform chain returned by the API into the model
+func (m *transformResourceModel) setTransform(ctx context.Context, transform *client.TransformRead) diag.Diagnostics {
+	m.ID = types.StringValue(transform.ID)
+	m.Name = types.StringValue(transform.Name)
+	m.Description = types.StringValue(transform.Description)
+	m.TeamID = types.StringPointerValue(transform.TeamID)
+	m.Revision = types.Int64Value(transform.Revision)
+
+	steps, diags := transformStepsValue(ctx, transform.Steps)
+	m.Steps = steps
+	return diags
+}
+
+// Metadata returns the resource type name
+func (r *transformResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_transform"
+}
+
+// Schema defines the resource schema
+func (r *transformResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a reusable chain of Popsink message transforms, applied in order, that pipelines can reference.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the transform chain.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the transform chain.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Short description of the transform chain.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"team_id": schema.StringAttribute{
+				Description: "The UUID of the team the transform chain is restricted to. Transform chains without team are shared by all teams.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"steps": transformStepsAttribute("The transforms of the chain, applied in order.", true),
+			"revision": schema.Int64Attribute{
+				Description: "The revision of the transform chain, incremented by every change of its steps. " +
+					"Reference it from pipelines so that they are redeployed when the chain changes.",
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource
+func (r *transformResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ConfigValidators returns the resource-level configuration validators
+func (r *transformResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		transformStepsConfigValidator{attribute: "steps"},
+	}
+}
+
+// ModifyPlan keeps the revision known unless the steps change, so that
+// pipelines referencing it only show a change when the transforms do
+func (r *transformResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planSteps, stateSteps types.List
+	var revision types.Int64
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("steps"), &planSteps)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("steps"), &stateSteps)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("revision"), &revision)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !planSteps.Equal(stateSteps) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("revision"), revision)...)
+}
+
+// Create creates the resource
+func (r *transformResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan transformResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	steps, diags := transformSteps(ctx, plan.Steps)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create transform chain
+	createReq := &client.TransformCreate{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		TeamID:      plan.TeamID.ValueStringPointer(),
+		Steps:       steps,
+	}
+
+	transform, err := r.client.CreateTransform(ctx, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Transform",
+			fmt.Sprintf("Could not create transform: %s", err.Error()),
+		)
+		return
+	}
+
+	// Update state with created transform chain
+	resp.Diagnostics.Append(plan.setTransform(ctx, transform)...)
+
+	tflog.Info(ctx, "Created transform", map[string]any{"id": transform.ID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the resource state
+func (r *transformResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state transformResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	transform, err := r.client.GetTransform(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Transform",
+			fmt.Sprintf("Could not read transform %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// If transform chain not found, remove from state
+	if transform == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Update state
+	resp.Diagnostics.Append(state.setTransform(ctx, transform)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource
+func (r *transformResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan transformResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state transformResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build update request
+	updateReq := &client.TransformUpdate{}
+
+	if !plan.Name.Equal(state.Name) {
+		name := plan.Name.ValueString()
+		updateReq.Name = &name
+	}
+
+	if !plan.Description.Equal(state.Description) {
+		description := plan.Description.ValueString()
+		updateReq.Description = &description
+	}
+
+	if !plan.Steps.Equal(state.Steps) {
+		steps, diags := transformSteps(ctx, plan.Steps)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		updateReq.Steps = &steps
+	}
+
+	// Update transform chain
+	transform, err := r.client.UpdateTransform(ctx, state.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Transform",
+			fmt.Sprintf("Could not update transform %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// Update state
+	resp.Diagnostics.Append(plan.setTransform(ctx, transform)...)
+
+	tflog.Info(ctx, "Updated transform", map[string]any{"id": transform.ID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource
+func (r *transformResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state transformResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteTransform(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Transform",
+			fmt.Sprintf("Could not delete transform %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Deleted transform", map[string]any{"id": state.ID.ValueString()})
+}
+
+// ImportState imports the resource state
+func (r *transformResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
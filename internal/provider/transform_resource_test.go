@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// transformStepType is the Terraform type of a transform of an ordered transform list
+var transformStepType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+	"type":              tftypes.String,
+	"condition":         tftypes.String,
+	"field":             tftypes.String,
+	"new_name":          tftypes.String,
+	"replacement":       tftypes.String,
+	"to_type":           tftypes.String,
+	"topic_regex":       tftypes.String,
+	"topic_replacement": tftypes.String,
+}}
+
+// transformStepList builds an ordered transform list value from the given string arguments, leaving the rest null
+func transformStepList(steps ...map[string]string) tftypes.Value {
+	elements := make([]tftypes.Value, 0, len(steps))
+	for _, step := range steps {
+		attributes := make(map[string]tftypes.Value, len(transformStepType.AttributeTypes))
+		for name := range transformStepType.AttributeTypes {
+			attributes[name] = tftypes.NewValue(tftypes.String, nil)
+		}
+		for name, value := range step {
+			attributes[name] = tftypes.NewValue(tftypes.String, value)
+		}
+		elements = append(elements, tftypes.NewValue(transformStepType, attributes))
+	}
+
+	return tftypes.NewValue(tftypes.List{ElementType: transformStepType}, elements)
+}
+
+func TestTransformStepsConfigValidator(t *testing.T) {
+	tests := []struct {
+		name        string
+		steps       []map[string]string
+		wantError   bool
+		wantSummary string
+	}{
+		{
+			name: "valid chain",
+			steps: []map[string]string{
+				{"type": "filter", "condition": "value.amount > 0"},
+				{"type": "rename_field", "field": "amt", "new_name": "amount"},
+				{"type": "mask", "field": "card_number"},
+				{"type": "cast", "field": "amount", "to_type": "float64"},
+				{"type": "route_by_topic", "topic_regex": "orders-(.*)", "topic_replacement": "orders_$1"},
+			},
+			wantError: false,
+		},
+		{
+			name: "missing required argument",
+			steps: []map[string]string{
+				{"type": "rename_field", "field": "amt"},
+			},
+			wantError:   true,
+			wantSummary: "Missing Transform Argument",
+		},
+		{
+			name: "argument of another type",
+			steps: []map[string]string{
+				{"type": "drop_field", "field": "amt", "condition": "true"},
+			},
+			wantError:   true,
+			wantSummary: "Unexpected Transform Argument",
+		},
+		{
+			name: "invalid topic regex",
+			steps: []map[string]string{
+				{"type": "route_by_topic", "topic_regex": "orders-(", "topic_replacement": "orders"},
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := resourceConfig(t, NewTransformResource(), map[string]tftypes.Value{
+				"name":  tftypes.NewValue(tftypes.String, "orders"),
+				"steps": transformStepList(tt.steps...),
+			})
+			req := resource.ValidateConfigRequest{Config: config}
+			resp := &resource.ValidateConfigResponse{}
+
+			transformStepsConfigValidator{attribute: "steps"}.ValidateResource(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("ValidateResource() diagnostics = %v, wantError %v", resp.Diagnostics, tt.wantError)
+			}
+
+			if tt.wantSummary != "" && (len(resp.Diagnostics) != 1 || resp.Diagnostics[0].Summary() != tt.wantSummary) {
+				t.Errorf("expected a %q diagnostic, got %v", tt.wantSummary, resp.Diagnostics)
+			}
+		})
+	}
+}
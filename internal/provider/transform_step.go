@@ -0,0 +1,273 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+// Transform types and the arguments they use
+var transformStepSpecs = map[string]argumentSpec{
+	"filter":         {required: []string{"condition"}},
+	"rename_field":   {required: []string{"field", "new_name"}},
+	"drop_field":     {required: []string{"field"}},
+	"mask":           {required: []string{"field"}, optional: []string{"replacement"}},
+	"cast":           {required: []string{"field", "to_type"}},
+	"route_by_topic": {required: []string{"topic_regex", "topic_replacement"}},
+}
+
+// Valid transform types
+var validTransformTypes = func() []string {
+	names := make([]string, 0, len(transformStepSpecs))
+	for transformType := range transformStepSpecs {
+		names = append(names, transformType)
+	}
+	sort.Strings(names)
+	return names
+}()
+
+// Valid target types of cast transforms
+var validCastTypes = []string{
+	"string",
+	"int32",
+	"int64",
+	"float32",
+	"float64",
+	"boolean",
+}
+
+// Type-specific transform arguments
+var transformStepArguments = []string{
+	"condition",
+	"field",
+	"new_name",
+	"replacement",
+	"to_type",
+	"topic_regex",
+	"topic_replacement",
+}
+
+// transformStepModel describes a single transform of an ordered transform list
+type transformStepModel struct {
+	Type             types.String `tfsdk:"type"`
+	Condition        types.String `tfsdk:"condition"`
+	Field            types.String `tfsdk:"field"`
+	NewName          types.String `tfsdk:"new_name"`
+	Replacement      types.String `tfsdk:"replacement"`
+	ToType           types.String `tfsdk:"to_type"`
+	TopicRegex       types.String `tfsdk:"topic_regex"`
+	TopicReplacement types.String `tfsdk:"topic_replacement"`
+}
+
+// arguments returns the type-specific arguments of the transform by name
+func (s *transformStepModel) arguments() map[string]attr.Value {
+	return map[string]attr.Value{
+		"condition":         s.Condition,
+		"field":             s.Field,
+		"new_name":          s.NewName,
+		"replacement":       s.Replacement,
+		"to_type":           s.ToType,
+		"topic_regex":       s.TopicRegex,
+		"topic_replacement": s.TopicReplacement,
+	}
+}
+
+// transformStep converts the model to its API representation
+func (s *transformStepModel) transformStep() client.TransformStep {
+	return client.TransformStep{
+		Type:             s.Type.ValueString(),
+		Condition:        s.Condition.ValueStringPointer(),
+		Field:            s.Field.ValueStringPointer(),
+		NewName:          s.NewName.ValueStringPointer(),
+		Replacement:      s.Replacement.ValueStringPointer(),
+		ToType:           s.ToType.ValueStringPointer(),
+		TopicRegex:       s.TopicRegex.ValueStringPointer(),
+		TopicReplacement: s.TopicReplacement.ValueStringPointer(),
+	}
+}
+
+// newTransformStepModel converts an API transform to its model
+func newTransformStepModel(step client.TransformStep) transformStepModel {
+	return transformStepModel{
+		Type:             types.StringValue(step.Type),
+		Condition:        types.StringPointerValue(step.Condition),
+		Field:            types.StringPointerValue(step.Field),
+		NewName:          types.StringPointerValue(step.NewName),
+		Replacement:      types.StringPointerValue(step.Replacement),
+		ToType:           types.StringPointerValue(step.ToType),
+		TopicRegex:       types.StringPointerValue(step.TopicRegex),
+		TopicReplacement: types.StringPointerValue(step.TopicReplacement),
+	}
+}
+
+// transformStepAttrTypes returns the attribute types of a transform of an ordered transform list
+func transformStepAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"type":              types.StringType,
+		"condition":         types.StringType,
+		"field":             types.StringType,
+		"new_name":          types.StringType,
+		"replacement":       types.StringType,
+		"to_type":           types.StringType,
+		"topic_regex":       types.StringType,
+		"topic_replacement": types.StringType,
+	}
+}
+
+// transformSteps converts an ordered transform list to its API representation
+func transformSteps(ctx context.Context, list types.List) ([]client.TransformStep, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if list.IsNull() || list.IsUnknown() {
+		return nil, diags
+	}
+
+	var models []transformStepModel
+	diags.Append(list.ElementsAs(ctx, &models, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	steps := make([]client.TransformStep, 0, len(models))
+	for i := range models {
+		steps = append(steps, models[i].transformStep())
+	}
+	return steps, diags
+}
+
+// transformStepsValue converts API transforms to an ordered transform list, which is null when there are none
+func transformStepsValue(ctx context.Context, steps []client.TransformStep) (types.List, diag.Diagnostics) {
+	elementType := types.ObjectType{AttrTypes: transformStepAttrTypes()}
+	if len(steps) == 0 {
+		return types.ListNull(elementType), nil
+	}
+
+	models := make([]transformStepModel, 0, len(steps))
+	for _, step := range steps {
+		models = append(models, newTransformStepModel(step))
+	}
+	return types.ListValueFrom(ctx, elementType, models)
+}
+
+// transformStepsAttribute returns the schema of an ordered list of transforms
+func transformStepsAttribute(description string, required bool) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: description,
+		Required:    required,
+		Optional:    !required,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Description: fmt.Sprintf("The type of the transform. Valid values: %v.", validTransformTypes),
+					Required:    true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(validTransformTypes...),
+					},
+				},
+				"condition": schema.StringAttribute{
+					Description: "The expression records must match to be kept. Used by filter.",
+					Optional:    true,
+				},
+				"field": schema.StringAttribute{
+					Description: "The record field the transform applies to. Used by rename_field, drop_field, mask and cast.",
+					Optional:    true,
+				},
+				"new_name": schema.StringAttribute{
+					Description: "The new name of the field. Used by rename_field.",
+					Optional:    true,
+				},
+				"replacement": schema.StringAttribute{
+					Description: "The value masked fields are replaced with. Used by mask, which defaults to an empty value of the field type.",
+					Optional:    true,
+				},
+				"to_type": schema.StringAttribute{
+					Description: fmt.Sprintf("The type the field is converted to. Used by cast. Valid values: %v.", validCastTypes),
+					Optional:    true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(validCastTypes...),
+					},
+				},
+				"topic_regex": schema.StringAttribute{
+					Description: "The regular expression matched against the record topic. Used by route_by_topic.",
+					Optional:    true,
+				},
+				"topic_replacement": schema.StringAttribute{
+					Description: "The destination topic, which may reference groups of topic_regex such as $1. Used by route_by_topic.",
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
+
+// transformStepsConfigValidator validates that every transform of an ordered
+// transform list only sets, and sets all, the arguments used by its type
+type transformStepsConfigValidator struct {
+	attribute string
+}
+
+// Description returns a description of the validator
+func (v transformStepsConfigValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("validates that every transform of %s sets the arguments required by its type and no other", v.attribute)
+}
+
+// MarkdownDescription returns a markdown description of the validator
+func (v transformStepsConfigValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateResource performs the validation
+func (v transformStepsConfigValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	attributePath := path.Root(v.attribute)
+
+	var steps types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, attributePath, &steps)...)
+	if resp.Diagnostics.HasError() || steps.IsNull() || steps.IsUnknown() {
+		return
+	}
+
+	var models []transformStepModel
+	resp.Diagnostics.Append(steps.ElementsAs(ctx, &models, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i := range models {
+		stepPath := attributePath.AtListIndex(i)
+		step := &models[i]
+
+		// Invalid types are reported by the type attribute validators
+		spec, ok := transformStepSpecs[step.Type.ValueString()]
+		if step.Type.IsUnknown() || !ok {
+			continue
+		}
+
+		subject := step.Type.ValueString() + " transforms"
+		resp.Diagnostics.Append(spec.validate(stepPath, "Transform", subject, transformStepArguments, step.arguments())...)
+
+		if !step.TopicRegex.IsNull() && !step.TopicRegex.IsUnknown() {
+			if _, err := regexp.Compile(step.TopicRegex.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					stepPath.AtName("topic_regex"),
+					"Invalid Topic Regular Expression",
+					fmt.Sprintf("topic_regex must be a valid regular expression: %s", err.Error()),
+				)
+			}
+		}
+	}
+}
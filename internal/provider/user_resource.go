@@ -0,0 +1,333 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+// Valid organization roles
+var validOrganizationRoles = []string{
+	"owner",
+	"admin",
+	"member",
+}
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ resource.Resource                = &userResource{}
+	_ resource.ResourceWithConfigure   = &userResource{}
+	_ resource.ResourceWithImportState = &userResource{}
+)
+
+// NewUserResource creates a new user resource
+func NewUserResource() resource.Resource {
+	return &userResource{}
+}
+
+// userResource defines the resource implementation
+type userResource struct {
+	client *client.Client
+}
+
+// userResourceModel describes the resource data model
+type userResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Email       types.String `tfsdk:"email"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Role        types.String `tfsdk:"role"`
+	Status      types.String `tfsdk:"status"`
+}
+
+// Metadata returns the resource type name
+func (r *userResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+// Schema defines the resource schema
+func (r *userResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Popsink user. Creating the resource invites the user to the organization.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the user.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Description: "The email address the invitation is sent to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Description: "The display name of the user.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: "The organization role of the user. Valid values: owner, admin, member.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(validOrganizationRoles...),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the user: active once the invitation is accepted, pending otherwise.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource
+func (r *userResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource. Pending or expired invitations for the same
+// email are re-sent instead of failing, so that creating the resource is idempotent.
+func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan userResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	email := plan.Email.ValueString()
+
+	users, err := r.client.ListUsers(ctx, client.UserFilter{Email: email})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Looking Up User",
+			fmt.Sprintf("Could not look up user %s: %s", email, err.Error()),
+		)
+		return
+	}
+
+	var existing *client.UserRead
+	for i := range users {
+		if strings.EqualFold(users[i].Email, email) {
+			existing = &users[i]
+			break
+		}
+	}
+
+	var user *client.UserRead
+	switch {
+	case existing == nil:
+		inviteReq := &client.UserInvite{
+			Email: email,
+			Role:  plan.Role.ValueString(),
+		}
+		if !plan.DisplayName.IsNull() && !plan.DisplayName.IsUnknown() {
+			displayName := plan.DisplayName.ValueString()
+			inviteReq.DisplayName = &displayName
+		}
+
+		user, err = r.client.InviteUser(ctx, inviteReq)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Inviting User",
+				fmt.Sprintf("Could not invite user %s: %s", email, err.Error()),
+			)
+			return
+		}
+
+	case existing.Status == client.UserStatusActive:
+		resp.Diagnostics.AddError(
+			"User Already Exists",
+			fmt.Sprintf("User %s is already an active member of the organization. "+
+				"Import it with: terraform import <address> %s", email, existing.ID),
+		)
+		return
+
+	default:
+		if _, err := r.client.ResendInvitation(ctx, existing.ID); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Re-sending Invitation",
+				fmt.Sprintf("Could not re-send the invitation of user %s: %s", email, err.Error()),
+			)
+			return
+		}
+
+		updateReq := &client.UserUpdate{}
+		role := plan.Role.ValueString()
+		updateReq.Role = &role
+		if !plan.DisplayName.IsNull() && !plan.DisplayName.IsUnknown() {
+			displayName := plan.DisplayName.ValueString()
+			updateReq.DisplayName = &displayName
+		}
+
+		user, err = r.client.UpdateUser(ctx, existing.ID, updateReq)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating User",
+				fmt.Sprintf("Could not update user %s: %s", email, err.Error()),
+			)
+			return
+		}
+	}
+
+	// Update state with the invited user
+	plan.ID = types.StringValue(user.ID)
+	plan.DisplayName = types.StringValue(user.DisplayName)
+	plan.Role = types.StringValue(user.Role)
+	plan.Status = types.StringValue(string(user.Status))
+
+	tflog.Info(ctx, "Invited user", map[string]any{"id": user.ID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the resource state
+func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state userResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.client.GetUser(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading User",
+			fmt.Sprintf("Could not read user %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// If user not found, remove from state
+	if user == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// If the invitation expired, remove from state so that the next apply re-invites the user
+	if user.Status == client.UserStatusExpired {
+		tflog.Warn(ctx, "User invitation expired, it will be re-sent on the next apply", map[string]any{"id": user.ID})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Update state
+	state.Email = userEmailValue(state.Email, user.Email)
+	state.DisplayName = types.StringValue(user.DisplayName)
+	state.Role = types.StringValue(user.Role)
+	state.Status = types.StringValue(string(user.Status))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource
+func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan userResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state userResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build update request
+	updateReq := &client.UserUpdate{}
+
+	if !plan.DisplayName.Equal(state.DisplayName) && !plan.DisplayName.IsUnknown() {
+		displayName := plan.DisplayName.ValueString()
+		updateReq.DisplayName = &displayName
+	}
+
+	if !plan.Role.Equal(state.Role) {
+		role := plan.Role.ValueString()
+		updateReq.Role = &role
+	}
+
+	// Update user
+	user, err := r.client.UpdateUser(ctx, state.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating User",
+			fmt.Sprintf("Could not update user %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// Update state
+	plan.ID = types.StringValue(user.ID)
+	plan.Email = userEmailValue(plan.Email, user.Email)
+	plan.DisplayName = types.StringValue(user.DisplayName)
+	plan.Role = types.StringValue(user.Role)
+	plan.Status = types.StringValue(string(user.Status))
+
+	tflog.Info(ctx, "Updated user", map[string]any{"id": user.ID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource
+func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state userResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteUser(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting User",
+			fmt.Sprintf("Could not delete user %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Deleted user", map[string]any{"id": state.ID.ValueString()})
+}
+
+// ImportState imports the resource state
+func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// userEmailValue returns the email reported by the API, keeping the prior value when it only
+// differs in case, since emails are matched case-insensitively
+func userEmailValue(prior types.String, email string) types.String {
+	if !prior.IsNull() && !prior.IsUnknown() && strings.EqualFold(prior.ValueString(), email) {
+		return prior
+	}
+	return types.StringValue(email)
+}
@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUserEmailValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		prior types.String
+		email string
+		want  types.String
+	}{
+		{name: "same email", prior: types.StringValue("jane@example.com"), email: "jane@example.com", want: types.StringValue("jane@example.com")},
+		{name: "different case", prior: types.StringValue("Jane@Example.com"), email: "jane@example.com", want: types.StringValue("Jane@Example.com")},
+		{name: "different email", prior: types.StringValue("jane@example.com"), email: "john@example.com", want: types.StringValue("john@example.com")},
+		{name: "after import", prior: types.StringNull(), email: "jane@example.com", want: types.StringValue("jane@example.com")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := userEmailValue(tt.prior, tt.email); !got.Equal(tt.want) {
+				t.Errorf("userEmailValue() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
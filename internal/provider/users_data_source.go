@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/popsink/terraform-provider-popsink/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ datasource.DataSource              = &usersDataSource{}
+	_ datasource.DataSourceWithConfigure = &usersDataSource{}
+)
+
+// NewUsersDataSource creates a new users data source
+func NewUsersDataSource() datasource.DataSource {
+	return &usersDataSource{}
+}
+
+// usersDataSource defines the data source implementation
+type usersDataSource struct {
+	client *client.Client
+}
+
+// usersDataSourceModel describes the data source data model
+type usersDataSourceModel struct {
+	Email types.String         `tfsdk:"email"`
+	Role  types.String         `tfsdk:"role"`
+	Users []usersDataUserModel `tfsdk:"users"`
+}
+
+// usersDataUserModel describes a single user of the users list
+type usersDataUserModel struct {
+	ID          types.String `tfsdk:"id"`
+	Email       types.String `tfsdk:"email"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Role        types.String `tfsdk:"role"`
+	Status      types.String `tfsdk:"status"`
+}
+
+// Metadata returns the data source type name
+func (d *usersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+// Schema defines the data source schema
+func (d *usersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the users of the Popsink organization, including pending invitations.",
+		Attributes: map[string]schema.Attribute{
+			"email": schema.StringAttribute{
+				Description: "Only return the user with this email address.",
+				Optional:    true,
+			},
+			"role": schema.StringAttribute{
+				Description: "Only return users with this organization role. Valid values: owner, admin, member.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(validOrganizationRoles...),
+				},
+			},
+			"users": schema.ListNestedAttribute{
+				Description: "The users matching the filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the user.",
+							Computed:    true,
+						},
+						"email": schema.StringAttribute{
+							Description: "The email address of the user.",
+							Computed:    true,
+						},
+						"display_name": schema.StringAttribute{
+							Description: "The display name of the user.",
+							Computed:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "The organization role of the user.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the user: active, pending or expired.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source
+func (d *usersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the data source state
+func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config usersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := d.client.ListUsers(ctx, client.UserFilter{
+		Email: config.Email.ValueString(),
+		Role:  config.Role.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Users",
+			fmt.Sprintf("Could not list users: %s", err.Error()),
+		)
+		return
+	}
+
+	config.Users = make([]usersDataUserModel, 0, len(users))
+	for _, user := range users {
+		config.Users = append(config.Users, usersDataUserModel{
+			ID:          types.StringValue(user.ID),
+			Email:       types.StringValue(user.Email),
+			DisplayName: types.StringValue(user.DisplayName),
+			Role:        types.StringValue(user.Role),
+			Status:      types.StringValue(string(user.Status)),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}